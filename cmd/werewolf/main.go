@@ -3,12 +3,22 @@ package main
 import (
 	"log"
 	"net"
+	"time"
 
-	pb "liam/pkg/werewolf"
+	"liam/config"
+	"liam/internal/models"
+	"liam/internal/services"
+	"liam/pkg/identity"
+	pb "liam/pkg/werewolf/v1"
+	"liam/repositories"
 	"liam/services/werewolf"
 
+	"github.com/go-redis/redis/v8"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
 )
 
 func main() {
@@ -17,11 +27,43 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
 
-	// 注册狼人杀服务
 	werewolfService := werewolf.NewWerewolfServer()
+	werewolfService.SetRateLimit(float64(cfg.Game.ActionRateLimit), cfg.Game.ActionBurst)
+
+	// 所有请求都需要携带网关签名的玩家身份，拦截器链见 ServerOptions
+	signer := identity.NewSigner(cfg.Game.IdentitySecret)
+	grpcServer := grpc.NewServer(append(werewolfService.ServerOptions(signer),
+		// 允许网关在空闲时每 30 秒发送一次心跳
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             20 * time.Second,
+			PermitWithoutStream: true,
+		}),
+	)...)
+
+	// 注册狼人杀服务
 	pb.RegisterWerewolfServiceServer(grpcServer, werewolfService)
+	// 兼容期：旧版客户端仍使用未带版本号的服务名
+	werewolf.RegisterLegacyService(grpcServer, werewolfService)
+
+	// 对局归档、战绩统计和匹配等级分，数据库不可用时游戏服务照常运行
+	if db, err := openDB(cfg); err != nil {
+		log.Printf("Game archive disabled: %v", err)
+	} else {
+		redisRepo := repositories.NewRedisRepository(redis.NewClient(&redis.Options{
+			Addr:     cfg.Redis.Addr,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+			PoolSize: cfg.Redis.PoolSize,
+		}))
+		statsService := services.NewStatsService(repositories.NewStatsRepository(db), redisRepo, repositories.NewUserRepository(db))
+		werewolfService.SetGameStore(repositories.NewGameRepository(db), statsService)
+		werewolfService.SetRatingStore(statsService)
+	}
 
 	// 启动反射服务
 	reflection.Register(grpcServer)
@@ -31,3 +73,14 @@ func main() {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 }
+
+func openDB(cfg *config.Config) (*gorm.DB, error) {
+	db, err := gorm.Open(mysql.Open(cfg.Database.DSN), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&models.Game{}, &models.GameParticipant{}, &models.GameEvent{}, &models.PlayerStats{}, &models.PlayerRoleStats{}); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -17,6 +18,8 @@ type Config struct {
 	Email    EmailConfig
 	OSS      OSSConfig
 	JWT      JWTConfig
+	Game     GameServerConfig
+	WS       WebSocketConfig
 }
 
 type ServerConfig struct {
@@ -55,6 +58,27 @@ type JWTConfig struct {
 	Secret string
 }
 
+// GameServerConfig 网关与狼人杀游戏服务之间的配置
+type GameServerConfig struct {
+	IdentitySecret string // 签名玩家身份的共享密钥，未配置时使用 JWT 密钥
+	// 游戏服务地址，按顺序连接，前面的不可用时切换到后面的
+	// 房间保存在游戏服务的内存中，多个地址用于主备切换而不是负载均衡
+	Addresses        []string
+	RequestTimeout   time.Duration // 一元请求未设置截止时间时的默认超时
+	BreakerThreshold int           // 连续失败多少次后熔断
+	BreakerCooldown  time.Duration // 熔断持续时间，之后放行一个探测请求
+	// 游戏服务按玩家限制行动、投票和聊天等请求的频率
+	ActionRateLimit int // 每秒请求数，0 表示不限流
+	ActionBurst     int // 允许的突发请求数
+}
+
+// WebSocketConfig 网关 WebSocket 连接的配置
+type WebSocketConfig struct {
+	AllowedOrigins []string      // 允许的 Origin，未配置时只允许同源，"*" 允许所有来源
+	TicketTTL      time.Duration // 连接凭证有效期
+	InstanceID     string        // 网关实例ID，多实例部署时用于跨实例投递，未配置时启动时随机生成
+}
+
 func LoadConfig() (*Config, error) {
 	// 加载 .env 文件
 	wd, _ := os.Getwd()
@@ -105,6 +129,20 @@ func LoadConfig() (*Config, error) {
 		JWT: JWTConfig{
 			Secret: os.Getenv("APP_JWT_SECRET"),
 		},
+		Game: GameServerConfig{
+			IdentitySecret:   os.Getenv("APP_GAME_IDENTITY_SECRET"),
+			Addresses:        getEnvAsList("APP_GAME_SERVER_ADDRS"),
+			RequestTimeout:   getEnvAsDuration("APP_GAME_REQUEST_TIMEOUT", 5*time.Second),
+			BreakerThreshold: getEnvAsInt("APP_GAME_BREAKER_THRESHOLD", 5),
+			BreakerCooldown:  getEnvAsDuration("APP_GAME_BREAKER_COOLDOWN", 10*time.Second),
+			ActionRateLimit:  getEnvAsInt("APP_GAME_ACTION_RATE_LIMIT", 5),
+			ActionBurst:      getEnvAsInt("APP_GAME_ACTION_BURST", 10),
+		},
+		WS: WebSocketConfig{
+			AllowedOrigins: getEnvAsList("APP_WS_ALLOWED_ORIGINS"),
+			TicketTTL:      getEnvAsDuration("APP_WS_TICKET_TTL", 30*time.Second),
+			InstanceID:     os.Getenv("APP_WS_INSTANCE_ID"),
+		},
 	}
 
 	// 验证必需的配置
@@ -114,6 +152,12 @@ func LoadConfig() (*Config, error) {
 	if cfg.JWT.Secret == "" {
 		return nil, fmt.Errorf("APP_JWT_SECRET is required")
 	}
+	if cfg.Game.IdentitySecret == "" {
+		cfg.Game.IdentitySecret = cfg.JWT.Secret
+	}
+	if len(cfg.Game.Addresses) == 0 {
+		cfg.Game.Addresses = []string{"localhost:50051"}
+	}
 
 	return cfg, nil
 }
@@ -139,3 +183,14 @@ func getEnvAsDuration(key string, defaultVal time.Duration) time.Duration {
 	}
 	return defaultVal
 }
+
+// getEnvAsList 读取逗号分隔的列表，忽略空项
+func getEnvAsList(key string) []string {
+	var values []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -31,4 +31,105 @@ CREATE TABLE IF NOT EXISTS `market_prices` (
     INDEX idx_market_prices_pro_id (`pro_id`),
     INDEX idx_market_prices_market_id (`market_id`),
     INDEX idx_market_prices_price_date (`price_date`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 创建 games 表（已结束的狼人杀对局）
+CREATE TABLE IF NOT EXISTS `games` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `created_at` DATETIME NOT NULL,
+    `updated_at` DATETIME NOT NULL,
+    `deleted_at` DATETIME NULL,
+    `room_id` VARCHAR(64) NOT NULL,
+    `room_name` VARCHAR(255),
+    `winner` VARCHAR(32),
+    `day_count` BIGINT,
+    `player_count` BIGINT,
+    `started_at` DATETIME,
+    `finished_at` DATETIME,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX idx_games_room_id (`room_id`),
+    INDEX idx_games_finished_at (`finished_at`),
+    INDEX idx_games_deleted_at (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 创建 game_participants 表
+CREATE TABLE IF NOT EXISTS `game_participants` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `created_at` DATETIME NOT NULL,
+    `updated_at` DATETIME NOT NULL,
+    `deleted_at` DATETIME NULL,
+    `game_id` BIGINT UNSIGNED NOT NULL,
+    `user_id` BIGINT UNSIGNED NULL,
+    `player_id` VARCHAR(64) NOT NULL,
+    `name` VARCHAR(255),
+    `position` BIGINT,
+    `role` VARCHAR(32),
+    `camp` VARCHAR(32),
+    `won` TINYINT(1),
+    `survived` TINYINT(1),
+    `death_cause` VARCHAR(32),
+    `death_day` BIGINT,
+    `correct_checks` BIGINT,
+    `successful_saves` BIGINT,
+    PRIMARY KEY (`id`),
+    INDEX idx_game_participants_game_id (`game_id`),
+    INDEX idx_game_participants_user_id (`user_id`),
+    INDEX idx_game_participants_player_id (`player_id`),
+    INDEX idx_game_participants_deleted_at (`deleted_at`),
+    CONSTRAINT fk_games_participants FOREIGN KEY (`game_id`) REFERENCES `games` (`id`),
+    CONSTRAINT fk_game_participants_user FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 创建 game_events 表（对局时间线）
+CREATE TABLE IF NOT EXISTS `game_events` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `game_id` BIGINT UNSIGNED NOT NULL,
+    `day` BIGINT,
+    `phase` VARCHAR(32),
+    `actor_id` VARCHAR(64),
+    `action` VARCHAR(32),
+    `target_id` VARCHAR(64),
+    `death_cause` VARCHAR(32),
+    `description` VARCHAR(512),
+    `occurred_at` DATETIME,
+    PRIMARY KEY (`id`),
+    INDEX idx_game_events_game_id (`game_id`),
+    CONSTRAINT fk_games_events FOREIGN KEY (`game_id`) REFERENCES `games` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 创建 player_stats 表（用户累计战绩）
+CREATE TABLE IF NOT EXISTS `player_stats` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `created_at` DATETIME NOT NULL,
+    `updated_at` DATETIME NOT NULL,
+    `deleted_at` DATETIME NULL,
+    `user_id` BIGINT UNSIGNED NOT NULL,
+    `rating` BIGINT DEFAULT 1500,
+    `games_played` BIGINT,
+    `wins` BIGINT,
+    `werewolf_games` BIGINT,
+    `werewolf_wins` BIGINT,
+    `villager_games` BIGINT,
+    `villager_wins` BIGINT,
+    `games_survived` BIGINT,
+    `correct_checks` BIGINT,
+    `successful_saves` BIGINT,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX idx_player_stats_user_id (`user_id`),
+    INDEX idx_player_stats_deleted_at (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 创建 player_role_stats 表（用户按角色统计的战绩）
+CREATE TABLE IF NOT EXISTS `player_role_stats` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `created_at` DATETIME NOT NULL,
+    `updated_at` DATETIME NOT NULL,
+    `deleted_at` DATETIME NULL,
+    `user_id` BIGINT UNSIGNED NOT NULL,
+    `role` VARCHAR(32) NOT NULL,
+    `games` BIGINT,
+    `wins` BIGINT,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX idx_player_role_stats_user_role (`user_id`, `role`),
+    INDEX idx_player_role_stats_deleted_at (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
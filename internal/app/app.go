@@ -9,6 +9,7 @@ import (
 	"liam/internal/controllers/user"
 	werewolf "liam/internal/controllers/werewolf"
 	"liam/internal/websocket"
+	"liam/pkg/identity"
 	"liam/pkg/middleware"
 	"log"
 	"os"
@@ -25,6 +26,7 @@ import (
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -66,7 +68,7 @@ func Run() error {
 	sqlDB.SetConnMaxLifetime(time.Hour)
 
 	// 5. Migrate
-	if err = db.AutoMigrate(&models.User{}); err != nil {
+	if err = db.AutoMigrate(&models.User{}, &models.Game{}, &models.GameParticipant{}, &models.GameEvent{}, &models.PlayerStats{}, &models.PlayerRoleStats{}); err != nil {
 		return err
 	}
 	log.Println("Database migration completed!")
@@ -90,6 +92,8 @@ func Run() error {
 	// 8. DI
 	userRepo := repositories.NewUserRepository(db)
 	marketPriceRepo := repositories.NewMarketPriceRepository(db)
+	gameRepo := repositories.NewGameRepository(db)
+	statsRepo := repositories.NewStatsRepository(db)
 	redisRepo := repositories.NewRedisRepository(redisClient)
 	emailService := services.NewEmailService(emailSender, redisRepo, userRepo)
 	userService := services.NewUserService(userRepo, emailService)
@@ -104,15 +108,24 @@ func Run() error {
 		log.Printf("Failed to start market price cron: %v", err)
 	}
 
-	grpcClient, err := client.NewWerewolfGRPCClient("localhost:50051")
+	// 连接在第一次请求时建立，游戏服务未启动时网关照常启动，相关请求返回 503
+	grpcClient, err := client.NewWerewolfGRPCClient(&cfg.Game, identity.NewSigner(cfg.Game.IdentitySecret))
 	if err != nil {
-		log.Fatalf("Failed to connect to gRPC server: %v", err)
+		log.Fatalf("Failed to create gRPC client: %v", err)
 	}
 	defer grpcClient.Close()
-	werewolfService := services.NewWerewolfService(grpcClient)
-	wsHandler := websocket.NewWSHandler(grpcClient)
-	wsManager := client.NewWSManager(grpcClient)
-	werewolfController := werewolf.NewWerewolfController(werewolfService, wsManager)
+	werewolfService := services.NewWerewolfService(grpcClient, gameRepo)
+	wsTicketService := services.NewWSTicketService(redisRepo, cfg.WS.TicketTTL)
+	instanceID := cfg.WS.InstanceID
+	if instanceID == "" {
+		instanceID = uuid.NewString()
+	}
+	presenceService := services.NewPresenceService(redisRepo, instanceID)
+	wsHub := websocket.NewHub(grpcClient, wsTicketService, presenceService, cfg.WS.AllowedOrigins)
+	go wsHub.Run(ctx)
+	werewolfController := werewolf.NewWerewolfController(werewolfService, wsHub)
+	statsService := services.NewStatsService(statsRepo, redisRepo, userRepo)
+	statsController := werewolf.NewStatsController(statsService)
 
 	// 9. Router
 	r := gin.Default()
@@ -122,7 +135,7 @@ func Run() error {
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "Last-Event-ID"},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
@@ -132,7 +145,7 @@ func Run() error {
 	routes.PublicRoutes(r, userController)
 	routes.ProtectedRoutes(r, userController)
 	routes.MarketPriceRoutes(r, marketPriceController)
-	routes.WolfGameRoutes(r, werewolfController, wsHandler)
+	routes.WolfGameRoutes(r, werewolfController, statsController, wsHub)
 
 	// 10. Start server
 	return r.Run(":8080") // 或从 cfg 读取端口
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errCircuitOpen 熔断期间直接返回，不再等待游戏服务
+var errCircuitOpen = status.Error(codes.Unavailable, "游戏服务暂不可用，请稍后重试")
+
+// breaker 熔断器：连续失败达到阈值后在 cooldown 内直接拒绝请求，之后放行一个探测请求，成功则恢复
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool // 冷却结束后已放行探测请求，等待结果
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{threshold: threshold, cooldown: cooldown}
+}
+
+// allow 判断是否放行请求，probe 表示该请求是冷却结束后放行的探测请求
+func (b *breaker) allow() (probe bool, err error) {
+	if b.threshold <= 0 {
+		return false, nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return false, nil
+	}
+	if b.probing || time.Now().Before(b.openUntil) {
+		return false, errCircuitOpen
+	}
+	b.probing = true
+	return true, nil
+}
+
+// record 记录请求结果，只有游戏服务不可达或超时才算失败，业务错误说明服务正常
+// 只有探测请求结束时才清除探测标记，熔断前发出的慢请求不会让第二个探测请求通过
+func (b *breaker) record(err error, probe bool) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		b.failures++
+		if b.failures >= b.threshold {
+			b.openUntil = time.Now().Add(b.cooldown)
+		}
+	case codes.Canceled:
+		// 调用方取消，无法判断服务状态
+	default:
+		b.failures = 0
+	}
+	if probe {
+		b.probing = false
+	}
+}
+
+func (b *breaker) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		probe, err := b.allow()
+		if err != nil {
+			return err
+		}
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		b.record(err, probe)
+		return err
+	}
+}
+
+// streamInterceptor 只统计建立流的结果，流建立后的中断由订阅方重连
+func (b *breaker) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		probe, err := b.allow()
+		if err != nil {
+			return nil, err
+		}
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		b.record(err, probe)
+		return stream, err
+	}
+}
+
+// timeoutInterceptor 为没有截止时间的一元请求设置默认超时，避免游戏服务无响应时请求一直挂起
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok && timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBreaker(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "down")
+	b := newBreaker(2, 20*time.Millisecond)
+
+	// 业务错误和调用方取消不算失败
+	for _, err := range []error{status.Error(codes.NotFound, "房间不存在"), status.Error(codes.Canceled, "canceled"), nil} {
+		probe, allowErr := b.allow()
+		assert.NoError(t, allowErr)
+		b.record(err, probe)
+	}
+
+	// 连续失败达到阈值后熔断
+	for i := 0; i < 2; i++ {
+		probe, err := b.allow()
+		assert.NoError(t, err)
+		assert.False(t, probe)
+		b.record(unavailable, probe)
+	}
+	_, err := b.allow()
+	assert.Equal(t, errCircuitOpen, err)
+
+	// 冷却结束后只放行一个探测请求，探测失败重新熔断
+	time.Sleep(30 * time.Millisecond)
+	probe, err := b.allow()
+	assert.NoError(t, err)
+	assert.True(t, probe)
+	_, err = b.allow()
+	assert.Equal(t, errCircuitOpen, err)
+	b.record(unavailable, probe)
+	_, err = b.allow()
+	assert.Equal(t, errCircuitOpen, err)
+
+	// 熔断前发出的慢请求结束时不清除探测标记
+	time.Sleep(30 * time.Millisecond)
+	probe, err = b.allow()
+	assert.NoError(t, err)
+	assert.True(t, probe)
+	b.record(unavailable, false)
+	_, err = b.allow()
+	assert.Equal(t, errCircuitOpen, err)
+
+	// 探测成功后恢复
+	b.record(nil, probe)
+	probe, err = b.allow()
+	assert.NoError(t, err)
+	assert.False(t, probe)
+}
+
+func TestBreaker_Disabled(t *testing.T) {
+	b := newBreaker(0, time.Second)
+	for i := 0; i < 10; i++ {
+		probe, err := b.allow()
+		assert.NoError(t, err)
+		b.record(status.Error(codes.Unavailable, "down"), probe)
+	}
+}
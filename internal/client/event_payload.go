@@ -0,0 +1,39 @@
+package client
+
+import (
+	"encoding/json"
+
+	pb "liam/pkg/werewolf/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var payloadMarshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+// EventPayload 将 GameEvent 的 oneof payload 转换为 {"witch_prompt": {...}} 形式，
+// 键为 proto 字段名，新增的 payload 类型无需修改此处
+// 没有 payload 时返回 nil
+func EventPayload(event *pb.GameEvent) map[string]json.RawMessage {
+	return oneofPayload(event, "payload")
+}
+
+// SessionResult 将会话响应的 oneof 结果转换为 {"vote": {...}} 形式
+func SessionResult(resp *pb.SessionResponse) map[string]json.RawMessage {
+	return oneofPayload(resp, "response")
+}
+
+func oneofPayload(msg proto.Message, oneof protoreflect.Name) map[string]json.RawMessage {
+	m := msg.ProtoReflect()
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName(oneof))
+	if field == nil {
+		return nil
+	}
+
+	data, err := payloadMarshaler.Marshal(m.Get(field).Message().Interface())
+	if err != nil {
+		return nil
+	}
+	return map[string]json.RawMessage{string(field.Name()): data}
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"io"
+	"log"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	resubscribeInitialBackoff = 200 * time.Millisecond
+	resubscribeMaxBackoff     = 10 * time.Second
+	// resubscribeResetAfter 订阅持续了这么久才中断时，退避从头开始
+	resubscribeResetAfter = 30 * time.Second
+)
+
+// Resubscribe 建立流订阅并在可重试的错误后按指数退避重新订阅，直到 ctx 取消或遇到不可重试的错误
+// subscribe 建立订阅并接收到流结束，返回结束的原因；重新订阅时从哪里继续由 subscribe 自己记录
+func Resubscribe(ctx context.Context, name string, subscribe func(ctx context.Context) error) error {
+	var b backoff
+	for {
+		start := time.Now()
+		err := subscribe(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !Retryable(err) {
+			return err
+		}
+
+		wait := b.next(time.Since(start))
+		log.Printf("%s 中断，%v 后重新订阅: %v", name, wait, err)
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// backoff 重新订阅的指数退避
+type backoff struct {
+	current time.Duration
+}
+
+// next 返回下一次重新订阅前的等待时间，lasted 为刚中断的订阅持续的时间
+func (b *backoff) next(lasted time.Duration) time.Duration {
+	if b.current == 0 || lasted > resubscribeResetAfter {
+		b.current = resubscribeInitialBackoff
+	}
+	// 加入随机抖动，避免游戏服务恢复时所有订阅同时重连
+	wait := b.current/2 + time.Duration(rand.Int63n(int64(b.current)))
+	if b.current *= 2; b.current > resubscribeMaxBackoff {
+		b.current = resubscribeMaxBackoff
+	}
+	return wait
+}
+
+// Retryable 判断流中断后是否值得重新订阅，房间不存在、无权限等错误重试也不会成功
+func Retryable(err error) bool {
+	if err == io.EOF {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.Internal:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{io.EOF, true},
+		{status.Error(codes.Unavailable, ""), true},
+		{status.Error(codes.ResourceExhausted, ""), true},
+		{status.Error(codes.Aborted, ""), true},
+		{status.Error(codes.Internal, ""), true},
+		{status.Error(codes.NotFound, ""), false},
+		{status.Error(codes.PermissionDenied, ""), false},
+		{status.Error(codes.InvalidArgument, ""), false},
+		{status.Error(codes.Canceled, ""), false},
+		{errors.New("plain"), false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Retryable(tt.err), "%v", tt.err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	var b backoff
+	expected := resubscribeInitialBackoff
+	for i := 0; i < 10; i++ {
+		wait := b.next(0)
+		assert.GreaterOrEqual(t, wait, expected/2)
+		assert.Less(t, wait, expected*3/2)
+		if expected *= 2; expected > resubscribeMaxBackoff {
+			expected = resubscribeMaxBackoff
+		}
+	}
+	assert.Equal(t, resubscribeMaxBackoff, b.current)
+
+	// 订阅持续足够久才中断时，退避从头开始
+	wait := b.next(resubscribeResetAfter + time.Second)
+	assert.Less(t, wait, resubscribeInitialBackoff*3/2)
+	assert.Equal(t, 2*resubscribeInitialBackoff, b.current)
+}
+
+func TestResubscribe(t *testing.T) {
+	// 可重试的错误后重新订阅，不可重试的错误直接返回
+	calls := 0
+	notFound := status.Error(codes.NotFound, "房间不存在")
+	err := Resubscribe(context.Background(), "test", func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			return status.Error(codes.Unavailable, "down")
+		}
+		return notFound
+	})
+	assert.Equal(t, notFound, err)
+	assert.Equal(t, 2, calls)
+
+	// 等待重新订阅期间取消
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	start := time.Now()
+	err = Resubscribe(ctx, "test", func(ctx context.Context) error {
+		return io.EOF
+	})
+	assert.Equal(t, context.Canceled, err)
+	assert.Less(t, time.Since(start), resubscribeInitialBackoff)
+
+	// 订阅过程中取消时返回 ctx 的错误，而不是订阅的错误
+	ctx, cancel = context.WithCancel(context.Background())
+	err = Resubscribe(ctx, "test", func(ctx context.Context) error {
+		cancel()
+		return status.Error(codes.Unavailable, "down")
+	})
+	assert.Equal(t, context.Canceled, err)
+}
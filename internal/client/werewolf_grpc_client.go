@@ -5,10 +5,15 @@ import (
 	"fmt"
 	"time"
 
-	pb "liam/pkg/werewolf"
+	"liam/config"
+	"liam/pkg/identity"
+	pb "liam/pkg/werewolf/v1"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
 )
 
 type WerewolfGRPCClient struct {
@@ -16,16 +21,56 @@ type WerewolfGRPCClient struct {
 	client pb.WerewolfServiceClient
 }
 
-func NewWerewolfGRPCClient(address string) (*WerewolfGRPCClient, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+// gameServerScheme 游戏服务地址列表的解析方案，地址来自配置而不是 DNS
+const gameServerScheme = "werewolf"
 
-	conn, err := grpc.DialContext(ctx, address,
+// serviceConfig 按顺序连接配置的地址；只读的一元请求在游戏服务不可用时自动重试
+// 行动、投票等请求不重试，避免重复执行
+const serviceConfig = `{
+	"loadBalancingConfig": [{"pick_first": {}}],
+	"methodConfig": [{
+		"name": [
+			{"service": "werewolf.v1.WerewolfService", "method": "ListRooms"},
+			{"service": "werewolf.v1.WerewolfService", "method": "GetGameState"},
+			{"service": "werewolf.v1.WerewolfService", "method": "GetGameReport"},
+			{"service": "werewolf.v1.WerewolfService", "method": "GetAvailableActions"}
+		],
+		"retryPolicy": {
+			"maxAttempts": 3,
+			"initialBackoff": "0.1s",
+			"maxBackoff": "1s",
+			"backoffMultiplier": 2,
+			"retryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}]
+}`
+
+// NewWerewolfGRPCClient 创建游戏服务客户端，请求 context 中的玩家身份（identity.NewContext）由 signer 签名后转发
+// 连接在第一次请求时建立，游戏服务不可用时不影响网关启动；连续失败时熔断，请求直接返回 Unavailable
+func NewWerewolfGRPCClient(cfg *config.GameServerConfig, signer *identity.Signer) (*WerewolfGRPCClient, error) {
+	addresses := make([]resolver.Address, len(cfg.Addresses))
+	for i, addr := range cfg.Addresses {
+		addresses[i] = resolver.Address{Addr: addr}
+	}
+	r := manual.NewBuilderWithScheme(gameServerScheme)
+	r.InitialState(resolver.State{Addresses: addresses})
+
+	b := newBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown)
+	conn, err := grpc.NewClient(gameServerScheme+":///game",
+		grpc.WithResolvers(r),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		// 空闲时也保持心跳，及时发现断开的连接，需与游戏服务的 keepalive 策略匹配
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                30 * time.Second,
+			Timeout:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithChainUnaryInterceptor(b.unaryInterceptor(), timeoutInterceptor(cfg.RequestTimeout), signer.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(b.streamInterceptor(), signer.StreamClientInterceptor()),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("连接 gRPC 服务失败: %v", err)
+		return nil, fmt.Errorf("创建 gRPC 客户端失败: %v", err)
 	}
 
 	return &WerewolfGRPCClient{
@@ -38,61 +83,118 @@ func (c *WerewolfGRPCClient) Close() error {
 	return c.conn.Close()
 }
 
-// CreateRoom 创建游戏房间
-func (c *WerewolfGRPCClient) CreateRoom(ctx context.Context, roomName string, maxPlayers int32, roleConfig map[string]int32) (*pb.CreateRoomResponse, error) {
-	return c.client.CreateRoom(ctx, &pb.CreateRoomRequest{
-		RoomName:   roomName,
-		MaxPlayers: maxPlayers,
-		RoleConfig: roleConfig,
-	})
+// CreateRoom 创建游戏房间，房间选项较多，直接传入完整请求
+func (c *WerewolfGRPCClient) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest) (*pb.CreateRoomResponse, error) {
+	return c.client.CreateRoom(ctx, req)
 }
 
 // JoinRoom 加入房间
-func (c *WerewolfGRPCClient) JoinRoom(ctx context.Context, roomID, playerID, playerName string) (*pb.JoinRoomResponse, error) {
+func (c *WerewolfGRPCClient) JoinRoom(ctx context.Context, roomID, playerID, playerName string, spectate, godView bool) (*pb.JoinRoomResponse, error) {
 	return c.client.JoinRoom(ctx, &pb.JoinRoomRequest{
 		RoomId:     roomID,
 		PlayerId:   playerID,
 		PlayerName: playerName,
+		Spectate:   spectate,
+		GodView:    godView,
 	})
 }
 
-// StartGame 开始游戏
-func (c *WerewolfGRPCClient) StartGame(ctx context.Context, roomID string) (*pb.StartGameResponse, error) {
+// ListRooms 获取大厅房间列表
+func (c *WerewolfGRPCClient) ListRooms(ctx context.Context, includeFinished bool) (*pb.ListRoomsResponse, error) {
+	return c.client.ListRooms(ctx, &pb.ListRoomsRequest{IncludeFinished: includeFinished})
+}
+
+// StartGame 开始游戏，playerID 须为已入座的玩家或房主
+func (c *WerewolfGRPCClient) StartGame(ctx context.Context, roomID, playerID string) (*pb.StartGameResponse, error) {
 	return c.client.StartGame(ctx, &pb.StartGameRequest{
-		RoomId: roomID,
+		RoomId:   roomID,
+		PlayerId: playerID,
 	})
 }
 
 // NightAction 夜晚行动
-func (c *WerewolfGRPCClient) NightAction(ctx context.Context, roomID, playerID, targetID, actionType string) (*pb.NightActionResponse, error) {
+func (c *WerewolfGRPCClient) NightAction(ctx context.Context, roomID, playerID, targetID string, action pb.ActionType) (*pb.NightActionResponse, error) {
 	return c.client.NightAction(ctx, &pb.NightActionRequest{
 		RoomId:         roomID,
 		PlayerId:       playerID,
 		TargetPlayerId: targetID,
-		ActionType:     actionType,
+		Action:         action,
+	})
+}
+
+// SendChatMessage 发送聊天消息
+func (c *WerewolfGRPCClient) SendChatMessage(ctx context.Context, roomID, playerID string, channel pb.ChatChannel, content string) (*pb.SendChatMessageResponse, error) {
+	return c.client.SendChatMessage(ctx, &pb.SendChatMessageRequest{
+		RoomId:   roomID,
+		PlayerId: playerID,
+		Channel:  channel,
+		Content:  content,
 	})
 }
 
 // Vote 投票
-func (c *WerewolfGRPCClient) Vote(ctx context.Context, roomID, voterID, targetID string) (*pb.VoteResponse, error) {
+func (c *WerewolfGRPCClient) Vote(ctx context.Context, roomID, voterID, targetID string, abstain bool) (*pb.VoteResponse, error) {
 	return c.client.Vote(ctx, &pb.VoteRequest{
 		RoomId:   roomID,
 		VoterId:  voterID,
 		TargetId: targetID,
+		Abstain:  abstain,
 	})
 }
 
 // GetGameState 获取游戏状态
-func (c *WerewolfGRPCClient) GetGameState(ctx context.Context, roomID, playerID string) (*pb.GetGameStateResponse, error) {
+func (c *WerewolfGRPCClient) GetGameState(ctx context.Context, roomID, playerID string, spectator bool) (*pb.GetGameStateResponse, error) {
 	return c.client.GetGameState(ctx, &pb.GetGameStateRequest{
+		RoomId:    roomID,
+		PlayerId:  playerID,
+		Spectator: spectator,
+	})
+}
+
+// GetGameReport 获取复盘报告
+func (c *WerewolfGRPCClient) GetGameReport(ctx context.Context, roomID string) (*pb.GetGameReportResponse, error) {
+	return c.client.GetGameReport(ctx, &pb.GetGameReportRequest{RoomId: roomID})
+}
+
+// GetAvailableActions 获取玩家当前可执行的行动
+func (c *WerewolfGRPCClient) GetAvailableActions(ctx context.Context, roomID, playerID string) (*pb.GetAvailableActionsResponse, error) {
+	return c.client.GetAvailableActions(ctx, &pb.GetAvailableActionsRequest{
 		RoomId:   roomID,
 		PlayerId: playerID,
 	})
 }
 
-// SubscribeGameEvents 订阅游戏事件
-func (c *WerewolfGRPCClient) SubscribeGameEvents(ctx context.Context, roomID, playerID string) (pb.WerewolfService_SubscribeGameEventsClient, error) {
+// SubscribeGameEvents 订阅游戏事件，lastSeq 大于 0 时先补发之后的历史事件
+func (c *WerewolfGRPCClient) SubscribeGameEvents(ctx context.Context, roomID, playerID string, spectator bool, lastSeq int64) (pb.WerewolfService_SubscribeGameEventsClient, error) {
 	return c.client.SubscribeGameEvents(ctx, &pb.GetGameStateRequest{
+		RoomId:    roomID,
+		PlayerId:  playerID,
+		Spectator: spectator,
+		LastSeq:   lastSeq,
+	})
+}
+
+// JoinQueue 排队匹配，取消 ctx 即退出队列
+func (c *WerewolfGRPCClient) JoinQueue(ctx context.Context, playerID, playerName, board string) (pb.WerewolfService_JoinQueueClient, error) {
+	return c.client.JoinQueue(ctx, &pb.JoinQueueRequest{
+		PlayerId:   playerID,
+		PlayerName: playerName,
+		Board:      board,
+	})
+}
+
+// OpenSession 打开双向流会话，调用方需先发送 join
+func (c *WerewolfGRPCClient) OpenSession(ctx context.Context) (pb.WerewolfService_GameSessionClient, error) {
+	return c.client.GameSession(ctx)
+}
+
+// SubscribeRoom 订阅整个房间的事件，ctx 需携带网关身份；lastSeq 大于 0 时先补发之后的事件
+func (c *WerewolfGRPCClient) SubscribeRoom(ctx context.Context, roomID string, lastSeq int64) (pb.WerewolfService_SubscribeRoomClient, error) {
+	return c.client.SubscribeRoom(ctx, &pb.SubscribeRoomRequest{RoomId: roomID, LastSeq: lastSeq})
+}
+
+func (c *WerewolfGRPCClient) StopSpectating(ctx context.Context, roomID, playerID string) (*pb.StopSpectatingResponse, error) {
+	return c.client.StopSpectating(ctx, &pb.StopSpectatingRequest{
 		RoomId:   roomID,
 		PlayerId: playerID,
 	})
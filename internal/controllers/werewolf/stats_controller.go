@@ -0,0 +1,93 @@
+package controller
+
+import (
+	dto "liam/internal/dto/werewolf"
+	service "liam/internal/services"
+	"liam/pkg/errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StatsController struct {
+	service service.StatsService
+}
+
+func NewStatsController(service service.StatsService) *StatsController {
+	return &StatsController{
+		service: service,
+	}
+}
+
+// GetLeaderboard 排行榜
+// @Summary 排行榜（总榜、角色榜、周榜），按胜场数排序
+// @Tags Werewolf
+// @Produce json
+// @Param board query string false "overall/role/weekly"
+// @Param role query string false "角色，board=role 时必填"
+// @Param limit query int false "返回条数"
+// @Success 200 {object} dto.LeaderboardResponse
+// @Router /api/v1/leaderboard [get]
+func (ctrl *StatsController) GetLeaderboard(c *gin.Context) {
+	var query dto.LeaderboardQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Success: false,
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	resp, err := ctrl.service.GetLeaderboard(c.Request.Context(), &query)
+	if err != nil {
+		ctrl.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetUserStats 用户战绩
+// @Summary 用户战绩：场次、各阵营胜场、各角色胜率、存活率和关键行动统计
+// @Tags Werewolf
+// @Produce json
+// @Param id path int true "用户ID"
+// @Success 200 {object} dto.PlayerStatsResponse
+// @Router /api/v1/users/{id}/stats [get]
+func (ctrl *StatsController) GetUserStats(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Success: false,
+			Error:   "invalid_request",
+			Message: "invalid user id",
+		})
+		return
+	}
+
+	resp, err := ctrl.service.GetUserStats(c.Request.Context(), uint(userID))
+	if err != nil {
+		ctrl.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (ctrl *StatsController) handleError(c *gin.Context, err error) {
+	if errors.IsInvalidInput(err) {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Success: false,
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Success: false,
+		Error:   "service_error",
+		Message: err.Error(),
+	})
+}
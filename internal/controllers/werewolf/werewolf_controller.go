@@ -1,36 +1,45 @@
 package controller
 
 import (
-	"liam/internal/client"
+	"fmt"
 	dto "liam/internal/dto/werewolf"
 	service "liam/internal/services"
+	"liam/internal/websocket"
+	"liam/pkg/errors"
+	"liam/pkg/identity"
 	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
-	"github.com/gorilla/websocket"
 )
 
-var upgrader = websocket.Upgrader{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
-	CheckOrigin: func(r *http.Request) bool {
-		// 生产环境需要更严格的检查
-		return true
-	},
-}
-
 type WerewolfController struct {
-	service   *service.WerewolfService
-	wsManager *client.WSManager
+	service *service.WerewolfService
+	hub     *websocket.Hub
 }
 
-func NewWerewolfController(service *service.WerewolfService, wsManager *client.WSManager) *WerewolfController {
+func NewWerewolfController(service *service.WerewolfService, hub *websocket.Hub) *WerewolfController {
 	return &WerewolfController{
-		service:   service,
-		wsManager: wsManager,
+		service: service,
+		hub:     hub,
+	}
+}
+
+// ListRooms 大厅房间列表
+// @Summary 大厅房间列表
+// @Tags Werewolf
+// @Produce json
+// @Param include_finished query bool false "是否包含已结束的房间"
+// @Success 200 {object} dto.ListRoomsResponse
+// @Router /api/v1/rooms [get]
+func (ctrl *WerewolfController) ListRooms(c *gin.Context) {
+	resp, err := ctrl.service.ListRooms(c.Request.Context(), c.Query("include_finished") == "true")
+	if err != nil {
+		ctrl.handleError(c, err)
+		return
 	}
+
+	c.JSON(http.StatusOK, resp)
 }
 
 // CreateRoom 创建房间
@@ -52,13 +61,16 @@ func (ctrl *WerewolfController) CreateRoom(c *gin.Context) {
 		return
 	}
 
+	// 房主即当前登录用户
+	creatorID, ok := authorizePlayer(c, req.CreatorID)
+	if !ok {
+		return
+	}
+	req.CreatorID = creatorID
+
 	resp, err := ctrl.service.CreateRoom(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Success: false,
-			Error:   "service_error",
-			Message: err.Error(),
-		})
+		ctrl.handleError(c, err)
 		return
 	}
 
@@ -84,22 +96,26 @@ func (ctrl *WerewolfController) JoinRoom(c *gin.Context) {
 		return
 	}
 
-	// 生成玩家ID
-	playerID := uuid.New().String()
+	// 玩家ID即当前登录用户，昵称默认使用账号名
+	playerID, ok := authorizePlayer(c, req.PlayerID)
+	if !ok {
+		return
+	}
 	req.PlayerID = playerID
+	if name, _ := c.Get("user_name"); name != nil && name != "" {
+		req.PlayerName = fmt.Sprint(name)
+	}
+	if req.PlayerName == "" {
+		req.PlayerName = playerID
+	}
 
 	resp, err := ctrl.service.JoinRoom(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Success: false,
-			Error:   "service_error",
-			Message: err.Error(),
-		})
+		ctrl.handleError(c, err)
 		return
 	}
 
-	// 设置 Cookie 保存玩家ID
-	c.SetCookie("player_id", playerID, 3600*24, "/", "", false, true)
+	// 设置 Cookie 保存房间ID，玩家身份由登录令牌确定
 	c.SetCookie("room_id", req.RoomID, 3600*24, "/", "", false, true)
 
 	c.JSON(http.StatusOK, resp)
@@ -124,13 +140,16 @@ func (ctrl *WerewolfController) StartGame(c *gin.Context) {
 		return
 	}
 
+	// 只有已入座的玩家或房主可以开始游戏，由游戏服务校验
+	playerID, ok := authorizePlayer(c, req.PlayerID)
+	if !ok {
+		return
+	}
+	req.PlayerID = playerID
+
 	resp, err := ctrl.service.StartGame(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Success: false,
-			Error:   "service_error",
-			Message: err.Error(),
-		})
+		ctrl.handleError(c, err)
 		return
 	}
 
@@ -156,16 +175,52 @@ func (ctrl *WerewolfController) NightAction(c *gin.Context) {
 		return
 	}
 
+	playerID, ok := authorizePlayer(c, req.PlayerID)
+	if !ok {
+		return
+	}
+	req.PlayerID = playerID
+
 	resp, err := ctrl.service.NightAction(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		ctrl.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// SendChat 发送聊天消息
+// @Summary 发送聊天消息
+// @Tags Werewolf
+// @Accept json
+// @Produce json
+// @Param request body dto.ChatRequest true "聊天请求"
+// @Success 200 {object} dto.ChatResponse
+// @Router /api/v1/game/chat [post]
+func (ctrl *WerewolfController) SendChat(c *gin.Context) {
+	var req dto.ChatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Success: false,
-			Error:   "service_error",
+			Error:   "invalid_request",
 			Message: err.Error(),
 		})
 		return
 	}
 
+	playerID, ok := authorizePlayer(c, req.PlayerID)
+	if !ok {
+		return
+	}
+	req.PlayerID = playerID
+
+	resp, err := ctrl.service.SendChat(c.Request.Context(), &req)
+	if err != nil {
+		ctrl.handleError(c, err)
+		return
+	}
+
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -188,13 +243,15 @@ func (ctrl *WerewolfController) Vote(c *gin.Context) {
 		return
 	}
 
+	voterID, ok := authorizePlayer(c, req.VoterID)
+	if !ok {
+		return
+	}
+	req.VoterID = voterID
+
 	resp, err := ctrl.service.Vote(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Success: false,
-			Error:   "service_error",
-			Message: err.Error(),
-		})
+		ctrl.handleError(c, err)
 		return
 	}
 
@@ -206,71 +263,153 @@ func (ctrl *WerewolfController) Vote(c *gin.Context) {
 // @Tags Werewolf
 // @Produce json
 // @Param room_id query string true "房间ID"
-// @Param player_id query string true "玩家ID"
+// @Param player_id query string false "玩家ID，默认为当前登录用户"
+// @Param spectator query bool false "player_id 是否为观战者ID"
 // @Success 200 {object} dto.GameStateResponse
 // @Router /api/v1/game/state [get]
 func (ctrl *WerewolfController) GetGameState(c *gin.Context) {
 	roomID := c.Query("room_id")
-	playerID := c.Query("player_id")
+	spectator := c.Query("spectator") == "true"
 
-	if roomID == "" || playerID == "" {
+	if roomID == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Success: false,
 			Error:   "invalid_request",
-			Message: "room_id and player_id are required",
+			Message: "room_id is required",
 		})
 		return
 	}
 
-	resp, err := ctrl.service.GetGameState(c.Request.Context(), roomID, playerID)
+	playerID, ok := authorizePlayer(c, c.Query("player_id"))
+	if !ok {
+		return
+	}
+
+	resp, err := ctrl.service.GetGameState(c.Request.Context(), roomID, playerID, spectator)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		ctrl.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListGameHistory 对局历史
+// @Summary 分页查询已归档的对局
+// @Tags Werewolf
+// @Produce json
+// @Param page query int false "页码"
+// @Param page_size query int false "每页数量"
+// @Param player_id query string false "只查询该玩家参与的对局"
+// @Success 200 {object} dto.GameHistoryListResponse
+// @Router /api/v1/history [get]
+func (ctrl *WerewolfController) ListGameHistory(c *gin.Context) {
+	var query dto.GameHistoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Success: false,
-			Error:   "service_error",
+			Error:   "invalid_request",
 			Message: err.Error(),
 		})
 		return
 	}
 
+	resp, err := ctrl.service.ListGameHistory(c.Request.Context(), &query)
+	if err != nil {
+		ctrl.handleError(c, err)
+		return
+	}
+
 	c.JSON(http.StatusOK, resp)
 }
 
-// HandleWebSocket 处理 WebSocket 连接
-// @Summary WebSocket 连接
+// GetGameHistory 单局历史
+// @Summary 查询单局归档记录
 // @Tags Werewolf
+// @Produce json
+// @Param room_id path string true "房间ID"
+// @Success 200 {object} dto.GameHistory
+// @Router /api/v1/history/{room_id} [get]
+func (ctrl *WerewolfController) GetGameHistory(c *gin.Context) {
+	resp, err := ctrl.service.GetGameHistory(c.Request.Context(), c.Param("room_id"))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Success: false,
+				Error:   "not_found",
+				Message: "对局不存在",
+			})
+			return
+		}
+		ctrl.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetGameReport 获取复盘报告
+// @Summary 获取复盘报告
+// @Tags Werewolf
+// @Produce json
 // @Param room_id query string true "房间ID"
-// @Param player_id query string true "玩家ID"
-// @Router /ws [get]
-func (ctrl *WerewolfController) HandleWebSocket(c *gin.Context) {
+// @Success 200 {object} dto.GameReportResponse
+// @Router /api/v1/game/report [get]
+func (ctrl *WerewolfController) GetGameReport(c *gin.Context) {
 	roomID := c.Query("room_id")
-	playerID := c.Query("player_id")
-
-	// 验证参数
-	if roomID == "" || playerID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "room_id and player_id are required",
+	if roomID == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Success: false,
+			Error:   "invalid_request",
+			Message: "room_id is required",
 		})
 		return
 	}
 
-	// 升级 HTTP 连接为 WebSocket
-	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	resp, err := ctrl.service.GetGameReport(c.Request.Context(), roomID)
 	if err != nil {
-		log.Printf("WebSocket upgrade error: %v", err)
+		ctrl.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetAvailableActions 获取可执行行动
+// @Summary 获取玩家当前可执行的行动及合法目标
+// @Tags Werewolf
+// @Produce json
+// @Param room_id query string true "房间ID"
+// @Param player_id query string false "玩家ID，默认为当前登录用户"
+// @Success 200 {object} dto.AvailableActionsResponse
+// @Router /api/v1/game/actions [get]
+func (ctrl *WerewolfController) GetAvailableActions(c *gin.Context) {
+	roomID := c.Query("room_id")
+
+	if roomID == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Success: false,
+			Error:   "invalid_request",
+			Message: "room_id is required",
+		})
+		return
+	}
+
+	playerID, ok := authorizePlayer(c, c.Query("player_id"))
+	if !ok {
 		return
 	}
 
-	// 注册客户端
-	if err := ctrl.wsManager.RegisterClient(conn, roomID, playerID); err != nil {
-		log.Printf("注册客户端失败: %v", err)
-		conn.Close()
+	resp, err := ctrl.service.GetAvailableActions(c.Request.Context(), roomID, playerID)
+	if err != nil {
+		ctrl.handleError(c, err)
 		return
 	}
 
-	log.Printf("WebSocket 连接建立: room=%s, player=%s", roomID, playerID)
+	c.JSON(http.StatusOK, resp)
 }
 
-// GetRoomPlayers 获取房间玩家列表
+// GetRoomPlayers 获取房间玩家列表，包括每个座位玩家的连接状态、最后在线时间和准备状态
 // @Summary 获取房间玩家列表
 // @Tags Werewolf
 // @Produce json
@@ -288,13 +427,30 @@ func (ctrl *WerewolfController) GetRoomPlayers(c *gin.Context) {
 		return
 	}
 
-	// 获取在线玩家数
-	onlineCount := ctrl.wsManager.GetOnlinePlayerCount(roomID)
+	playerID, ok := authorizePlayer(c, "")
+	if !ok {
+		return
+	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"success":      true,
-		"room_id":      roomID,
-		"online_count": onlineCount,
+	// 玩家列表按请求者的视角过滤身份
+	state, err := ctrl.service.GetGameState(c.Request.Context(), roomID, playerID, false)
+	if err != nil {
+		ctrl.handleError(c, err)
+		return
+	}
+
+	// 获取在线连接数，包括观战者和连接在其他网关实例上的玩家
+	onlineCount, err := ctrl.hub.OnlineCount(c.Request.Context(), roomID)
+	if err != nil {
+		ctrl.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RoomPlayersResponse{
+		Success:     true,
+		RoomID:      roomID,
+		Players:     state.Players,
+		OnlineCount: onlineCount,
 	})
 }
 
@@ -317,8 +473,16 @@ func (ctrl *WerewolfController) LeaveRoom(c *gin.Context) {
 		return
 	}
 
-	// 断开 WebSocket 连接
-	ctrl.wsManager.UnregisterClient(req.PlayerID)
+	playerID, ok := authorizePlayer(c, req.PlayerID)
+	if !ok {
+		return
+	}
+	req.PlayerID = playerID
+
+	// 断开 WebSocket 连接，连接可能在其他网关实例上
+	if err := ctrl.hub.Disconnect(c.Request.Context(), req.PlayerID); err != nil {
+		log.Printf("Failed to disconnect player %s: %v", req.PlayerID, err)
+	}
 
 	// 清除 Cookie
 	c.SetCookie("player_id", "", -1, "/", "", false, true)
@@ -329,3 +493,23 @@ func (ctrl *WerewolfController) LeaveRoom(c *gin.Context) {
 		Message: "已离开房间",
 	})
 }
+
+// authorizePlayer 返回当前登录用户的玩家ID，请求中指定了其他玩家的ID时返回 403
+func authorizePlayer(c *gin.Context, claimed string) (string, bool) {
+	playerID, _ := identity.FromContext(c.Request.Context())
+	if claimed != "" && claimed != playerID {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Success: false,
+			Error:   "forbidden",
+			Message: "player_id does not match the authenticated user",
+		})
+		return "", false
+	}
+	return playerID, true
+}
+
+// handleError 将服务错误转换为 HTTP 响应，状态码和错误码见 service.ErrorResponse
+// 房间不存在、不是你的回合等业务错误返回 4xx，游戏服务不可用或熔断时返回 503，客户端可稍后重试
+func (ctrl *WerewolfController) handleError(c *gin.Context, err error) {
+	c.JSON(service.ErrorResponse(err))
+}
@@ -5,34 +5,78 @@ type CreateRoomRequest struct {
 	RoomName   string         `json:"room_name" binding:"required"`
 	MaxPlayers int            `json:"max_players" binding:"required,min=4,max=12"`
 	RoleConfig map[string]int `json:"role_config" binding:"required"`
+	// 可见性配置：死亡玩家/观战者是否获得上帝视角
+	DeadGodView      bool `json:"dead_god_view"`
+	SpectatorGodView bool `json:"spectator_god_view"`
+	// 超时策略：狼人超时随机击杀、投票超时随机投票（默认弃票）、预言家超时随机查验（默认不查验）、
+	// 连续超时多少次判定挂机、挂机后是否由机器人托管
+	WerewolfRandomKill bool `json:"werewolf_random_kill"`
+	VoteRandomTarget   bool `json:"vote_random_target"`
+	SeerRandomCheck    bool `json:"seer_random_check"`
+	AfkThreshold       int  `json:"afk_threshold" binding:"omitempty,min=1"`
+	BotTakeover        bool `json:"bot_takeover"`
+	// 匿名投票：只公布票数，不公布每个人的投票对象
+	AnonymousVote bool `json:"anonymous_vote"`
+	// 严格发言模式：白天按座位号轮流发言
+	StrictSpeaking bool `json:"strict_speaking"`
+	// 开局随机指定两名玩家结为情侣，情侣互相可见身份
+	RandomLovers bool `json:"random_lovers"`
+	// 房主，未入座时也可以开始游戏
+	CreatorID string `json:"creator_id,omitempty"` // 默认为当前登录用户，与之不符时拒绝
 }
 
 type JoinRoomRequest struct {
 	RoomID     string `json:"room_id" binding:"required"`
-	PlayerID   string `json:"player_id,omitempty"` // 由服务器生成
-	PlayerName string `json:"player_name" binding:"required,min=1,max=20"`
+	PlayerID   string `json:"player_id,omitempty"`                    // 默认为当前登录用户
+	PlayerName string `json:"player_name" binding:"omitempty,max=20"` // 默认使用账号名
+	// 以观战者身份加入，可选开启延迟上帝视角
+	Spectate bool `json:"spectate"`
+	GodView  bool `json:"god_view"`
 }
 
 type StartGameRequest struct {
-	RoomID string `json:"room_id" binding:"required"`
+	RoomID   string `json:"room_id" binding:"required"`
+	PlayerID string `json:"player_id,omitempty"` // 默认为当前登录用户，须为已入座的玩家或房主
 }
 
 type NightActionRequest struct {
 	RoomID     string `json:"room_id" binding:"required"`
-	PlayerID   string `json:"player_id" binding:"required"`
+	PlayerID   string `json:"player_id,omitempty"` // 默认为当前登录用户，与之不符时拒绝
 	TargetID   string `json:"target_id" binding:"required"`
 	ActionType string `json:"action_type" binding:"required"` // kill, check, save, poison, guard, skip
 }
 
 type VoteRequest struct {
 	RoomID   string `json:"room_id" binding:"required"`
-	VoterID  string `json:"voter_id" binding:"required"`
-	TargetID string `json:"target_id" binding:"required"`
+	VoterID  string `json:"voter_id,omitempty"` // 默认为当前登录用户，与之不符时拒绝
+	TargetID string `json:"target_id" binding:"required_without=Abstain"`
+	Abstain  bool   `json:"abstain"` // 弃票，此时忽略 target_id
+}
+
+type ChatRequest struct {
+	RoomID   string `json:"room_id" binding:"required"`
+	PlayerID string `json:"player_id,omitempty"` // 默认为当前登录用户，与之不符时拒绝
+	Channel  string `json:"channel" binding:"required,oneof=public werewolf dead spectator"`
+	Content  string `json:"content" binding:"required,max=200"`
 }
 
 type LeaveRoomRequest struct {
 	RoomID   string `json:"room_id" binding:"required"`
-	PlayerID string `json:"player_id" binding:"required"`
+	PlayerID string `json:"player_id,omitempty"` // 默认为当前登录用户，与之不符时拒绝
+}
+
+// 对局历史查询，player_id 不为空时只返回该玩家参与的对局
+type GameHistoryQuery struct {
+	Page     int    `form:"page,default=1" binding:"gte=1"`
+	PageSize int    `form:"page_size,default=10" binding:"gte=1,lte=100"`
+	PlayerID string `form:"player_id"`
+}
+
+// 排行榜查询：overall 总榜、role 角色榜（需指定 role）、weekly 周榜
+type LeaderboardQuery struct {
+	Board string `form:"board,default=overall" binding:"oneof=overall role weekly"`
+	Role  string `form:"role"`
+	Limit int    `form:"limit,default=20" binding:"gte=1,lte=100"`
 }
 
 // 响应 DTO
@@ -41,6 +85,20 @@ type CreateRoomResponse struct {
 	Message string `json:"message"`
 }
 
+type ListRoomsResponse struct {
+	Rooms []RoomSummary `json:"rooms"`
+}
+
+type RoomSummary struct {
+	RoomID         string `json:"room_id"`
+	RoomName       string `json:"room_name"`
+	State          string `json:"state"`
+	PlayerCount    int32  `json:"player_count"`
+	MaxPlayers     int32  `json:"max_players"`
+	SpectatorCount int32  `json:"spectator_count"`
+	DayCount       int32  `json:"day_count"`
+}
+
 type JoinRoomResponse struct {
 	Success  bool   `json:"success"`
 	Message  string `json:"message"`
@@ -56,9 +114,21 @@ type StartGameResponse struct {
 }
 
 type NightActionResponse struct {
+	Success    bool        `json:"success"`
+	Message    string      `json:"message"`
+	Result     string      `json:"result,omitempty"`
+	SeerResult *SeerResult `json:"seer_result,omitempty"`
+}
+
+type SeerResult struct {
+	TargetID string `json:"target_id"`
+	Camp     string `json:"camp"`
+	Day      int32  `json:"day"`
+}
+
+type ChatResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
-	Result  string `json:"result,omitempty"`
 }
 
 type VoteResponse struct {
@@ -73,6 +143,179 @@ type GetGameStateResponse struct {
 	Players       []PlayerInfo `json:"players"`
 	DayCount      int          `json:"day_count"`
 	CurrentPlayer *PlayerInfo  `json:"current_player,omitempty"`
+	// 仅当前玩家可见的私有信息
+	Knowledge *PrivateKnowledge `json:"knowledge,omitempty"`
+	// 历次投票结果
+	VoteHistory []VoteTally `json:"vote_history"`
+}
+
+type VoteTally struct {
+	Day          int32            `json:"day"`
+	Counts       map[string]int32 `json:"counts"`
+	EliminatedID string           `json:"eliminated_id"`
+	Abstentions  int32            `json:"abstentions"`
+	Ballots      []Ballot         `json:"ballots,omitempty"` // 匿名模式下为空
+}
+
+type Ballot struct {
+	VoterID  string `json:"voter_id"`
+	TargetID string `json:"target_id,omitempty"`
+	Abstain  bool   `json:"abstain"`
+}
+
+type PrivateKnowledge struct {
+	SeerChecks   []SeerResult        `json:"seer_checks,omitempty"`
+	WitchPotions []WitchPotionRecord `json:"witch_potions,omitempty"`
+	GuardRecords []GuardRecord       `json:"guard_records,omitempty"`
+	LoverID      string              `json:"lover_id,omitempty"`
+}
+
+type WitchPotionRecord struct {
+	Day      int32  `json:"day"`
+	Action   string `json:"action"`
+	TargetID string `json:"target_id"`
+}
+
+type GuardRecord struct {
+	Day      int32  `json:"day"`
+	TargetID string `json:"target_id"`
+}
+
+type GameReportResponse struct {
+	RoomID     string          `json:"room_id"`
+	RoomName   string          `json:"room_name"`
+	Winner     string          `json:"winner"`
+	DayCount   int32           `json:"day_count"`
+	StartedAt  int64           `json:"started_at"`
+	FinishedAt int64           `json:"finished_at"`
+	Players    []PlayerReveal  `json:"players"`
+	Timeline   []TimelineEntry `json:"timeline"`
+	Votes      []VoteTally     `json:"votes"`
+}
+
+type PlayerReveal struct {
+	PlayerInfo
+	DeathCause string `json:"death_cause,omitempty"`
+	DeathDay   int32  `json:"death_day,omitempty"`
+}
+
+type TimelineEntry struct {
+	Day         int32  `json:"day"`
+	Phase       string `json:"phase"`
+	ActorID     string `json:"actor_id,omitempty"`
+	Action      string `json:"action,omitempty"`
+	TargetID    string `json:"target_id,omitempty"`
+	DeathCause  string `json:"death_cause,omitempty"`
+	Description string `json:"description"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+type GameHistoryListResponse struct {
+	Games    []GameHistory `json:"games"`
+	Total    int64         `json:"total"`
+	Page     int           `json:"page"`
+	PageSize int           `json:"page_size"`
+}
+
+type GameHistory struct {
+	RoomID       string             `json:"room_id"`
+	RoomName     string             `json:"room_name"`
+	Winner       string             `json:"winner"`
+	DayCount     int                `json:"day_count"`
+	StartedAt    int64              `json:"started_at"`
+	FinishedAt   int64              `json:"finished_at"`
+	Participants []GameParticipant  `json:"participants"`
+	Events       []GameHistoryEvent `json:"events,omitempty"`
+}
+
+type GameParticipant struct {
+	PlayerID   string `json:"player_id"`
+	UserID     *uint  `json:"user_id,omitempty"`
+	Name       string `json:"name"`
+	Position   int    `json:"position"`
+	Role       string `json:"role"`
+	Camp       string `json:"camp"`
+	Won        bool   `json:"won"`
+	Survived   bool   `json:"survived"`
+	DeathCause string `json:"death_cause,omitempty"`
+	DeathDay   int    `json:"death_day,omitempty"`
+}
+
+type GameHistoryEvent struct {
+	Day         int    `json:"day"`
+	Phase       string `json:"phase"`
+	ActorID     string `json:"actor_id,omitempty"`
+	Action      string `json:"action,omitempty"`
+	TargetID    string `json:"target_id,omitempty"`
+	DeathCause  string `json:"death_cause,omitempty"`
+	Description string `json:"description"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+// 匹配状态，通过 WebSocket 的 queue_update 消息推送
+type QueueUpdate struct {
+	Status               string `json:"status"`
+	Board                string `json:"board"`
+	Position             int32  `json:"position,omitempty"`
+	QueueSize            int32  `json:"queue_size,omitempty"`
+	EstimatedWaitSeconds int32  `json:"estimated_wait_seconds"`
+	Rating               int32  `json:"rating"`
+	RoomID               string `json:"room_id,omitempty"`
+}
+
+// WebSocket 连接凭证，一次性使用，连接时通过 ticket 参数携带
+type WSTicketResponse struct {
+	Success   bool   `json:"success"`
+	Ticket    string `json:"ticket"`
+	ExpiresIn int64  `json:"expires_in"` // 秒
+}
+
+type LeaderboardResponse struct {
+	Board   string             `json:"board"`
+	Role    string             `json:"role,omitempty"`
+	Entries []LeaderboardEntry `json:"entries"`
+}
+
+type LeaderboardEntry struct {
+	Rank   int    `json:"rank"`
+	UserID uint   `json:"user_id"`
+	Name   string `json:"name"`
+	Wins   int    `json:"wins"`
+}
+
+type PlayerStatsResponse struct {
+	UserID          uint        `json:"user_id"`
+	Rating          int         `json:"rating"`
+	GamesPlayed     int         `json:"games_played"`
+	Wins            int         `json:"wins"`
+	WinRate         float64     `json:"win_rate"`
+	SurvivalRate    float64     `json:"survival_rate"`
+	CorrectChecks   int         `json:"correct_checks"`
+	SuccessfulSaves int         `json:"successful_saves"`
+	Werewolf        CampStats   `json:"werewolf"`
+	Villager        CampStats   `json:"villager"`
+	Roles           []RoleStats `json:"roles"`
+}
+
+type CampStats struct {
+	Games   int     `json:"games"`
+	Wins    int     `json:"wins"`
+	WinRate float64 `json:"win_rate"`
+}
+
+type RoleStats struct {
+	Role    string  `json:"role"`
+	Games   int     `json:"games"`
+	Wins    int     `json:"wins"`
+	WinRate float64 `json:"win_rate"`
+}
+
+type AvailableActionsResponse struct {
+	RoomID   string            `json:"room_id"`
+	PlayerID string            `json:"player_id"`
+	Phase    string            `json:"phase"`
+	Actions  []AvailableAction `json:"actions"`
+	Deadline int64             `json:"deadline"`
 }
 
 type RoomPlayersResponse struct {
@@ -91,6 +334,21 @@ type PlayerInfo struct {
 	IsAlive  bool   `json:"is_alive"`
 	Position int32  `json:"position"`
 	CanAct   bool   `json:"can_act"`
+	// 挂机及机器人托管状态
+	IsAfk         bool `json:"is_afk"`
+	BotControlled bool `json:"bot_controlled"`
+	Ready         bool `json:"ready"`
+	// 连接状态：online、reconnecting 或 offline，last_seen 为最近一次上线或断线的时间
+	Presence string `json:"presence,omitempty"`
+	LastSeen int64  `json:"last_seen,omitempty"`
+}
+
+type AvailableAction struct {
+	ActionType     string   `json:"action_type"`
+	TargetIDs      []string `json:"target_ids,omitempty"`
+	RequiresTarget bool     `json:"requires_target"`
+	RemainingUses  int32    `json:"remaining_uses"`
+	Description    string   `json:"description,omitempty"`
 }
 
 type PhaseInfo struct {
@@ -111,5 +369,6 @@ type Response struct {
 type ErrorResponse struct {
 	Success bool   `json:"success"`
 	Error   string `json:"error"`
+	Code    int    `json:"code,omitempty"` // 业务错误码，见 pkg/errors 和 services.ErrRoomNotFound 等
 	Message string `json:"message"`
 }
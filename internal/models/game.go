@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Game 已结束的狼人杀对局
+type Game struct {
+	gorm.Model
+
+	RoomID      string    `json:"room_id" gorm:"size:64; not null; uniqueIndex"`
+	RoomName    string    `json:"room_name" gorm:"size:255"`
+	Winner      string    `json:"winner" gorm:"size:32"`
+	DayCount    int       `json:"day_count"`
+	PlayerCount int       `json:"player_count"`
+	StartedAt   time.Time `json:"started_at"`
+	FinishedAt  time.Time `json:"finished_at" gorm:"index"`
+
+	Participants []GameParticipant `json:"participants,omitempty"`
+	Events       []GameEvent       `json:"events,omitempty"`
+}
+
+// GameParticipant 对局参与者，玩家ID对应已注册用户时关联到 User
+type GameParticipant struct {
+	gorm.Model
+
+	GameID     uint   `json:"game_id" gorm:"not null; index"`
+	UserID     *uint  `json:"user_id" gorm:"index"`
+	User       *User  `json:"-"`
+	PlayerID   string `json:"player_id" gorm:"size:64; not null; index"`
+	Name       string `json:"name" gorm:"size:255"`
+	Position   int    `json:"position"`
+	Role       string `json:"role" gorm:"size:32"`
+	Camp       string `json:"camp" gorm:"size:32"`
+	Won        bool   `json:"won"`
+	Survived   bool   `json:"survived"`
+	DeathCause string `json:"death_cause" gorm:"size:32"`
+	DeathDay   int    `json:"death_day"`
+	// 关键行动统计
+	CorrectChecks   int `json:"correct_checks"`
+	SuccessfulSaves int `json:"successful_saves"`
+}
+
+// GameEvent 对局时间线中的一条记录
+type GameEvent struct {
+	ID uint `json:"id" gorm:"primaryKey"`
+
+	GameID      uint      `json:"game_id" gorm:"not null; index"`
+	Day         int       `json:"day"`
+	Phase       string    `json:"phase" gorm:"size:32"`
+	ActorID     string    `json:"actor_id" gorm:"size:64"`
+	Action      string    `json:"action" gorm:"size:32"`
+	TargetID    string    `json:"target_id" gorm:"size:64"`
+	DeathCause  string    `json:"death_cause" gorm:"size:32"`
+	Description string    `json:"description" gorm:"size:512"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+// DefaultRating 新玩家的初始等级分
+const DefaultRating = 1500
+
+// PlayerStats 用户的累计战绩，每局结束时增量更新
+type PlayerStats struct {
+	gorm.Model
+
+	UserID          uint `json:"user_id" gorm:"not null; uniqueIndex"`
+	Rating          int  `json:"rating" gorm:"default: 1500"` // Elo 等级分
+	GamesPlayed     int  `json:"games_played"`
+	Wins            int  `json:"wins"`
+	WerewolfGames   int  `json:"werewolf_games"`
+	WerewolfWins    int  `json:"werewolf_wins"`
+	VillagerGames   int  `json:"villager_games"`
+	VillagerWins    int  `json:"villager_wins"`
+	GamesSurvived   int  `json:"games_survived"`
+	CorrectChecks   int  `json:"correct_checks"`   // 预言家查验出狼人的次数
+	SuccessfulSaves int  `json:"successful_saves"` // 女巫成功救人的次数
+}
+
+// PlayerRoleStats 用户按角色统计的战绩
+type PlayerRoleStats struct {
+	gorm.Model
+
+	UserID uint   `json:"user_id" gorm:"not null; uniqueIndex:idx_player_role_stats_user_role"`
+	Role   string `json:"role" gorm:"size:32; not null; uniqueIndex:idx_player_role_stats_user_role"`
+	Games  int    `json:"games"`
+	Wins   int    `json:"wins"`
+}
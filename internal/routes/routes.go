@@ -5,6 +5,7 @@ import (
 	"liam/internal/controllers/user"
 	werewolf "liam/internal/controllers/werewolf"
 	"liam/internal/websocket"
+	"liam/pkg/middleware"
 	"liam/utils"
 
 	"github.com/gin-gonic/gin"
@@ -26,14 +27,15 @@ func MarketPriceRoutes(r *gin.Engine, mc *market.MarketPriceController) {
 	auth.GET("/prices", mc.GetTodayPricese)
 }
 
-func WolfGameRoutes(r *gin.Engine, werewolfCtrl *werewolf.WerewolfController, wsHandler *websocket.WSHandler) {
+func WolfGameRoutes(r *gin.Engine, werewolfCtrl *werewolf.WerewolfController, statsCtrl *werewolf.StatsController, wsHub *websocket.Hub) {
 	// API v1
 	v1 := r.Group("/api/werewolf/v1")
-	v1.Use(utils.AuthRequired())
+	v1.Use(utils.AuthRequired(), middleware.PlayerIdentity())
 	{
 		// 房间路由
 		rooms := v1.Group("/rooms")
 		{
+			rooms.GET("", werewolfCtrl.ListRooms)
 			rooms.POST("", werewolfCtrl.CreateRoom)
 			rooms.POST("/join", werewolfCtrl.JoinRoom)
 			rooms.POST("/start", werewolfCtrl.StartGame)
@@ -46,12 +48,30 @@ func WolfGameRoutes(r *gin.Engine, werewolfCtrl *werewolf.WerewolfController, ws
 		{
 			game.POST("/night-action", werewolfCtrl.NightAction)
 			game.POST("/vote", werewolfCtrl.Vote)
+			game.POST("/chat", werewolfCtrl.SendChat)
 			game.GET("/state", werewolfCtrl.GetGameState)
+			game.GET("/actions", werewolfCtrl.GetAvailableActions)
+			game.GET("/report", werewolfCtrl.GetGameReport)
+			game.GET("/events", wsHub.StreamEvents)
 		}
+
+		// 对局历史路由
+		history := v1.Group("/history")
+		{
+			history.GET("", werewolfCtrl.ListGameHistory)
+			history.GET("/:room_id", werewolfCtrl.GetGameHistory)
+		}
+
+		// 战绩与排行榜
+		v1.GET("/leaderboard", statsCtrl.GetLeaderboard)
+		v1.GET("/users/:id/stats", statsCtrl.GetUserStats)
+
+		// WebSocket 连接凭证
+		v1.POST("/ws/ticket", wsHub.IssueTicket)
 	}
 
-	// WebSocket
-	r.GET("/ws", wsHandler.HandleWebSocket)
+	// WebSocket，鉴权由 handler 在握手后完成
+	r.GET("/ws", wsHub.HandleWebSocket)
 
 	// 健康检查
 	r.GET("/health", func(c *gin.Context) {
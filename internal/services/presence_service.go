@@ -0,0 +1,239 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"liam/repositories"
+	"log"
+	"strconv"
+	"time"
+)
+
+const (
+	PresencePlayerKeyPrefix = "werewolf:presence:player:" // 哈希：玩家连接所在的实例、连接ID、房间和最后活跃时间
+	PresenceRoomKeyPrefix   = "werewolf:presence:room:"   // 有序集合：房间内的在线玩家，分数为最后活跃时间
+	PresenceTTL             = 2 * time.Minute             // 超过该时间未活跃视为离线，需大于心跳间隔
+
+	RelayRoomChannel           = "werewolf:ws:rooms"     // 房间广播，所有实例订阅
+	RelayInstanceChannelPrefix = "werewolf:ws:instance:" // 发给指定实例的消息
+)
+
+// PlayerPresence 玩家的在线状态
+type PlayerPresence struct {
+	InstanceID string
+	ConnID     string
+	RoomID     string
+	LastSeen   time.Time
+}
+
+// RelayMessage 网关实例之间投递的消息
+type RelayMessage struct {
+	Origin    string          `json:"origin"` // 发布消息的实例，房间广播时跳过自己
+	RoomID    string          `json:"room_id,omitempty"`
+	PlayerID  string          `json:"player_id,omitempty"`
+	ConnID    string          `json:"conn_id,omitempty"` // 非空时按 CloseCode 断开该连接，如玩家在其他实例上重新连接
+	CloseCode int             `json:"close_code,omitempty"`
+	Reason    string          `json:"reason,omitempty"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// PresenceService 在线状态和跨实例投递，多个网关实例通过 Redis 共享
+type PresenceService interface {
+	InstanceID() string
+	// Connect 登记玩家在本实例上的连接，返回之前的在线状态，没有时为 nil
+	Connect(ctx context.Context, playerID, connID string) (*PlayerPresence, error)
+	// Touch 刷新最后活跃时间，roomID 非空时同时登记到房间；玩家已在其他连接上登记时忽略
+	Touch(ctx context.Context, playerID, connID, roomID string) error
+	// Disconnect 移除在线状态，玩家已在其他连接上登记时保留
+	Disconnect(ctx context.Context, playerID, connID, roomID string) error
+	Lookup(ctx context.Context, playerID string) (*PlayerPresence, error)
+	OnlineCount(ctx context.Context, roomID string) (int, error)
+
+	PublishToRoom(ctx context.Context, roomID string, data []byte) error
+	// PublishToInstance 发给指定实例，用于投递给该实例上的玩家或断开旧连接
+	PublishToInstance(ctx context.Context, instanceID string, msg *RelayMessage) error
+	// Subscribe 接收发给本实例的消息，ctx 取消后关闭通道
+	Subscribe(ctx context.Context) <-chan *RelayMessage
+}
+
+type presenceServiceImpl struct {
+	redisRepo  repositories.RedisRepository
+	instanceID string
+}
+
+func NewPresenceService(redisRepo repositories.RedisRepository, instanceID string) PresenceService {
+	return &presenceServiceImpl{
+		redisRepo:  redisRepo,
+		instanceID: instanceID,
+	}
+}
+
+func (s *presenceServiceImpl) InstanceID() string {
+	return s.instanceID
+}
+
+func (s *presenceServiceImpl) Connect(ctx context.Context, playerID, connID string) (*PlayerPresence, error) {
+	previous, err := s.Lookup(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := PresencePlayerKeyPrefix + playerID
+	if err := s.redisRepo.HSet(ctx, key,
+		"instance", s.instanceID,
+		"conn", connID,
+		"room", "",
+		"last_seen", time.Now().Unix(),
+	); err != nil {
+		return nil, fmt.Errorf("failed to store presence: %w", err)
+	}
+	if err := s.redisRepo.Expire(ctx, key, PresenceTTL); err != nil {
+		return nil, fmt.Errorf("failed to store presence: %w", err)
+	}
+	return previous, nil
+}
+
+func (s *presenceServiceImpl) Touch(ctx context.Context, playerID, connID, roomID string) error {
+	current, err := s.Lookup(ctx, playerID)
+	if err != nil {
+		return err
+	}
+	// 已过期的重新登记
+	if current != nil && current.ConnID != connID {
+		return nil
+	}
+
+	now := time.Now()
+	key := PresencePlayerKeyPrefix + playerID
+	if err := s.redisRepo.HSet(ctx, key,
+		"instance", s.instanceID,
+		"conn", connID,
+		"room", roomID,
+		"last_seen", now.Unix(),
+	); err != nil {
+		return fmt.Errorf("failed to refresh presence: %w", err)
+	}
+	if err := s.redisRepo.Expire(ctx, key, PresenceTTL); err != nil {
+		return fmt.Errorf("failed to refresh presence: %w", err)
+	}
+
+	if roomID == "" {
+		return nil
+	}
+	roomKey := PresenceRoomKeyPrefix + roomID
+	if err := s.redisRepo.ZAdd(ctx, roomKey, float64(now.Unix()), playerID); err != nil {
+		return fmt.Errorf("failed to refresh room presence: %w", err)
+	}
+	return s.redisRepo.Expire(ctx, roomKey, PresenceTTL)
+}
+
+func (s *presenceServiceImpl) Disconnect(ctx context.Context, playerID, connID, roomID string) error {
+	current, err := s.Lookup(ctx, playerID)
+	if err != nil {
+		return err
+	}
+	if current == nil || current.ConnID != connID {
+		return nil
+	}
+
+	if err := s.redisRepo.Del(ctx, PresencePlayerKeyPrefix+playerID); err != nil {
+		return fmt.Errorf("failed to remove presence: %w", err)
+	}
+	if roomID != "" {
+		return s.redisRepo.ZRem(ctx, PresenceRoomKeyPrefix+roomID, playerID)
+	}
+	return nil
+}
+
+func (s *presenceServiceImpl) Lookup(ctx context.Context, playerID string) (*PlayerPresence, error) {
+	fields, err := s.redisRepo.HGetAll(ctx, PresencePlayerKeyPrefix+playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get presence: %w", err)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	lastSeen, _ := strconv.ParseInt(fields["last_seen"], 10, 64)
+	return &PlayerPresence{
+		InstanceID: fields["instance"],
+		ConnID:     fields["conn"],
+		RoomID:     fields["room"],
+		LastSeen:   time.Unix(lastSeen, 0),
+	}, nil
+}
+
+// 超时未刷新的玩家在统计时清理
+func (s *presenceServiceImpl) OnlineCount(ctx context.Context, roomID string) (int, error) {
+	key := PresenceRoomKeyPrefix + roomID
+	cutoff := strconv.FormatInt(time.Now().Add(-PresenceTTL).Unix(), 10)
+	if err := s.redisRepo.ZRemRangeByScore(ctx, key, "-inf", "("+cutoff); err != nil {
+		return 0, fmt.Errorf("failed to prune room presence: %w", err)
+	}
+
+	count, err := s.redisRepo.ZCount(ctx, key, cutoff, "+inf")
+	if err != nil {
+		return 0, fmt.Errorf("failed to count room presence: %w", err)
+	}
+	return int(count), nil
+}
+
+func (s *presenceServiceImpl) PublishToRoom(ctx context.Context, roomID string, data []byte) error {
+	return s.publish(ctx, RelayRoomChannel, &RelayMessage{RoomID: roomID, Data: data})
+}
+
+func (s *presenceServiceImpl) PublishToInstance(ctx context.Context, instanceID string, msg *RelayMessage) error {
+	return s.publish(ctx, RelayInstanceChannelPrefix+instanceID, msg)
+}
+
+func (s *presenceServiceImpl) publish(ctx context.Context, channel string, msg *RelayMessage) error {
+	msg.Origin = s.instanceID
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err := s.redisRepo.Publish(ctx, channel, payload); err != nil {
+		return fmt.Errorf("failed to publish to %s: %w", channel, err)
+	}
+	return nil
+}
+
+func (s *presenceServiceImpl) Subscribe(ctx context.Context) <-chan *RelayMessage {
+	pubsub := s.redisRepo.Subscribe(ctx, RelayRoomChannel, RelayInstanceChannelPrefix+s.instanceID)
+	out := make(chan *RelayMessage, 256)
+
+	go func() {
+		defer close(out)
+		defer pubsub.Close()
+
+		ch := pubsub.Channel()
+		for {
+			select {
+			case m, ok := <-ch:
+				if !ok {
+					return
+				}
+				var msg RelayMessage
+				if err := json.Unmarshal([]byte(m.Payload), &msg); err != nil {
+					log.Printf("Invalid relay message on %s: %v", m.Channel, err)
+					continue
+				}
+				// 房间广播已在本实例直接投递
+				if m.Channel == RelayRoomChannel && msg.Origin == s.instanceID {
+					continue
+				}
+				select {
+				case out <- &msg:
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresence_ConnectionReplacement(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeRedis()
+	first := NewPresenceService(store, "gw-1")
+	second := NewPresenceService(store, "gw-2")
+
+	previous, err := first.Connect(ctx, "p1", "conn-a")
+	assert.NoError(t, err)
+	assert.Nil(t, previous)
+	assert.NoError(t, first.Touch(ctx, "p1", "conn-a", "room-1"))
+
+	// 在另一个实例上重新连接，返回旧连接所在的位置
+	previous, err = second.Connect(ctx, "p1", "conn-b")
+	assert.NoError(t, err)
+	assert.Equal(t, "gw-1", previous.InstanceID)
+	assert.Equal(t, "conn-a", previous.ConnID)
+	assert.Equal(t, "room-1", previous.RoomID)
+
+	// 旧连接的心跳和断开不覆盖新连接
+	assert.NoError(t, first.Touch(ctx, "p1", "conn-a", "room-1"))
+	assert.NoError(t, first.Disconnect(ctx, "p1", "conn-a", "room-1"))
+	current, err := second.Lookup(ctx, "p1")
+	assert.NoError(t, err)
+	assert.Equal(t, "gw-2", current.InstanceID)
+	assert.Equal(t, "conn-b", current.ConnID)
+
+	// 当前连接断开后不再在线
+	assert.NoError(t, second.Touch(ctx, "p1", "conn-b", "room-1"))
+	assert.NoError(t, second.Disconnect(ctx, "p1", "conn-b", "room-1"))
+	current, err = second.Lookup(ctx, "p1")
+	assert.NoError(t, err)
+	assert.Nil(t, current)
+	count, err := second.OnlineCount(ctx, "room-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestPresence_OnlineCount(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeRedis()
+	svc := NewPresenceService(store, "gw-1")
+
+	for _, player := range []string{"p1", "p2", "p3"} {
+		_, err := svc.Connect(ctx, player, "conn-"+player)
+		assert.NoError(t, err)
+		assert.NoError(t, svc.Touch(ctx, player, "conn-"+player, "room-1"))
+	}
+	// 超时未刷新的玩家不计入并被清理
+	stale := float64(time.Now().Add(-PresenceTTL - time.Minute).Unix())
+	assert.NoError(t, store.ZAdd(ctx, PresenceRoomKeyPrefix+"room-1", stale, "p3"))
+
+	count, err := svc.OnlineCount(ctx, "room-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.NotContains(t, store.scores[PresenceRoomKeyPrefix+"room-1"], "p3")
+}
+
+func TestPresence_Publish(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeRedis()
+	svc := NewPresenceService(store, "gw-1")
+
+	assert.NoError(t, svc.PublishToRoom(ctx, "room-1", []byte(`{"type":"game_event"}`)))
+	assert.NoError(t, svc.PublishToInstance(ctx, "gw-2", &RelayMessage{PlayerID: "p1", ConnID: "conn-a", CloseCode: 4000}))
+
+	var msg RelayMessage
+	assert.Len(t, store.published[RelayRoomChannel], 1)
+	assert.NoError(t, json.Unmarshal([]byte(store.published[RelayRoomChannel][0]), &msg))
+	assert.Equal(t, "gw-1", msg.Origin)
+	assert.Equal(t, "room-1", msg.RoomID)
+	assert.JSONEq(t, `{"type":"game_event"}`, string(msg.Data))
+
+	msg = RelayMessage{}
+	assert.Len(t, store.published[RelayInstanceChannelPrefix+"gw-2"], 1)
+	assert.NoError(t, json.Unmarshal([]byte(store.published[RelayInstanceChannelPrefix+"gw-2"][0]), &msg))
+	assert.Equal(t, "gw-1", msg.Origin)
+	assert.Equal(t, "conn-a", msg.ConnID)
+	assert.Equal(t, 4000, msg.CloseCode)
+}
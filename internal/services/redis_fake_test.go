@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"liam/repositories"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// fakeRedis 内存中的 RedisRepository，只实现测试用到的命令
+// 过期时间按 now 判断，测试可以替换 now 模拟时间流逝；failTx 次事务失败，失败时不写入任何加分，与 MULTI/EXEC 一致
+type fakeRedis struct {
+	repositories.RedisRepository
+
+	now       func() time.Time
+	strings   map[string]string
+	hashes    map[string]map[string]string
+	scores    map[string]map[string]float64
+	expires   map[string]time.Time
+	published map[string][]string
+	failTx    int
+}
+
+func newFakeRedis() *fakeRedis {
+	return &fakeRedis{
+		now:       time.Now,
+		strings:   make(map[string]string),
+		hashes:    make(map[string]map[string]string),
+		scores:    make(map[string]map[string]float64),
+		expires:   make(map[string]time.Time),
+		published: make(map[string][]string),
+	}
+}
+
+// expire 删除已过期的键
+func (f *fakeRedis) expire(key string) {
+	if at, ok := f.expires[key]; ok && !f.now().Before(at) {
+		delete(f.strings, key)
+		delete(f.hashes, key)
+		delete(f.scores, key)
+		delete(f.expires, key)
+	}
+}
+
+func (f *fakeRedis) setExpire(key string, expiration time.Duration) {
+	if expiration > 0 {
+		f.expires[key] = f.now().Add(expiration)
+	} else {
+		delete(f.expires, key)
+	}
+}
+
+func (f *fakeRedis) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	f.strings[key] = fmt.Sprint(value)
+	if b, ok := value.([]byte); ok {
+		f.strings[key] = string(b)
+	}
+	f.setExpire(key, expiration)
+	return nil
+}
+
+func (f *fakeRedis) GetDel(ctx context.Context, key string) (string, error) {
+	f.expire(key)
+	value, ok := f.strings[key]
+	if !ok {
+		return "", redis.Nil
+	}
+	delete(f.strings, key)
+	delete(f.expires, key)
+	return value, nil
+}
+
+func (f *fakeRedis) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	f.expire(key)
+	if _, ok := f.strings[key]; ok {
+		return false, nil
+	}
+	return true, f.Set(ctx, key, value, expiration)
+}
+
+func (f *fakeRedis) Del(ctx context.Context, key string) error {
+	delete(f.strings, key)
+	delete(f.hashes, key)
+	delete(f.scores, key)
+	delete(f.expires, key)
+	return nil
+}
+
+func (f *fakeRedis) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	f.setExpire(key, expiration)
+	return nil
+}
+
+func (f *fakeRedis) HSet(ctx context.Context, key string, values ...interface{}) error {
+	f.expire(key)
+	if f.hashes[key] == nil {
+		f.hashes[key] = make(map[string]string)
+	}
+	for i := 0; i+1 < len(values); i += 2 {
+		f.hashes[key][fmt.Sprint(values[i])] = fmt.Sprint(values[i+1])
+	}
+	return nil
+}
+
+func (f *fakeRedis) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	f.expire(key)
+	result := make(map[string]string)
+	for k, v := range f.hashes[key] {
+		result[k] = v
+	}
+	return result, nil
+}
+
+func (f *fakeRedis) ZAdd(ctx context.Context, key string, score float64, member string) error {
+	f.expire(key)
+	if f.scores[key] == nil {
+		f.scores[key] = make(map[string]float64)
+	}
+	f.scores[key][member] = score
+	return nil
+}
+
+func (f *fakeRedis) ZRem(ctx context.Context, key string, members ...interface{}) error {
+	for _, m := range members {
+		delete(f.scores[key], fmt.Sprint(m))
+	}
+	return nil
+}
+
+func (f *fakeRedis) ZCount(ctx context.Context, key string, min, max string) (int64, error) {
+	f.expire(key)
+	var count int64
+	for _, score := range f.scores[key] {
+		if inRange(score, min, max) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (f *fakeRedis) ZRemRangeByScore(ctx context.Context, key string, min, max string) error {
+	for member, score := range f.scores[key] {
+		if inRange(score, min, max) {
+			delete(f.scores[key], member)
+		}
+	}
+	return nil
+}
+
+func (f *fakeRedis) ZIncrByTx(ctx context.Context, increments []repositories.ZIncrement, expirations map[string]time.Duration) error {
+	if f.failTx > 0 {
+		f.failTx--
+		return stdErrors.New("connection reset")
+	}
+	for _, inc := range increments {
+		if f.scores[inc.Key] == nil {
+			f.scores[inc.Key] = make(map[string]float64)
+		}
+		f.scores[inc.Key][inc.Member] += inc.Increment
+	}
+	for key, expiration := range expirations {
+		f.setExpire(key, expiration)
+	}
+	return nil
+}
+
+func (f *fakeRedis) ZRevRangeWithScores(ctx context.Context, key string, start, stop int64) ([]redis.Z, error) {
+	var result []redis.Z
+	for member, score := range f.scores[key] {
+		result = append(result, redis.Z{Member: member, Score: score})
+	}
+	return result, nil
+}
+
+func (f *fakeRedis) Publish(ctx context.Context, channel string, message interface{}) error {
+	payload := fmt.Sprint(message)
+	if b, ok := message.([]byte); ok {
+		payload = string(b)
+	}
+	f.published[channel] = append(f.published[channel], payload)
+	return nil
+}
+
+// inRange 按 ZCOUNT 的语法判断分数是否在区间内，支持 -inf、+inf 和 ( 开区间
+func inRange(score float64, min, max string) bool {
+	return boundOK(score, min, true) && boundOK(score, max, false)
+}
+
+func boundOK(score float64, bound string, lower bool) bool {
+	switch bound {
+	case "-inf":
+		return true
+	case "+inf":
+		return true
+	}
+	exclusive := strings.HasPrefix(bound, "(")
+	value, _ := strconv.ParseFloat(strings.TrimPrefix(bound, "("), 64)
+	switch {
+	case lower && exclusive:
+		return score > value
+	case lower:
+		return score >= value
+	case exclusive:
+		return score < value
+	default:
+		return score <= value
+	}
+}
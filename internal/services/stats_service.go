@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	dto "liam/internal/dto/werewolf"
+	"liam/internal/models"
+	"liam/pkg/errors"
+	"liam/repositories"
+	"log"
+	"strconv"
+	"time"
+)
+
+const (
+	LeaderboardKeyPrefix = "werewolf:leaderboard:"
+	WeeklyLeaderboardTTL = 14 * 24 * time.Hour // 周榜保留两周
+	// 已计入排行榜的对局标记，保留到归档重试全部结束之后
+	LeaderboardRecordedKeyPrefix = LeaderboardKeyPrefix + "recorded:"
+	LeaderboardRecordedTTL       = 7 * 24 * time.Hour
+)
+
+// 排行榜类型
+const (
+	LeaderboardOverall = "overall"
+	LeaderboardRole    = "role"
+	LeaderboardWeekly  = "weekly"
+)
+
+type StatsService interface {
+	// RecordGame 对局归档后更新排行榜，只统计已关联用户的参与者
+	RecordGame(ctx context.Context, game *models.Game) error
+	GetLeaderboard(ctx context.Context, query *dto.LeaderboardQuery) (*dto.LeaderboardResponse, error)
+	GetUserStats(ctx context.Context, userID uint) (*dto.PlayerStatsResponse, error)
+	// GetRating 玩家当前等级分，玩家ID不是已注册用户或没有战绩时返回初始分
+	GetRating(ctx context.Context, playerID string) (int, error)
+}
+
+type statsServiceImpl struct {
+	statsRepo repositories.StatsRepository
+	redisRepo repositories.RedisRepository
+	userRepo  repositories.UserRepository // 用于排行榜显示用户名
+}
+
+func NewStatsService(statsRepo repositories.StatsRepository, redisRepo repositories.RedisRepository, userRepo repositories.UserRepository) StatsService {
+	return &statsServiceImpl{
+		statsRepo: statsRepo,
+		redisRepo: redisRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// RecordGame 排行榜按胜场数排序
+// 归档失败时会整体重试：先用标记键占用这局对局，已记录过的直接跳过；
+// 全部加分在一个事务中执行，失败时释放标记，重试时重新计分，不会重复或遗漏
+func (s *statsServiceImpl) RecordGame(ctx context.Context, game *models.Game) error {
+	recordedKey := LeaderboardRecordedKeyPrefix + game.RoomID
+	claimed, err := s.redisRepo.SetNX(ctx, recordedKey, 1, LeaderboardRecordedTTL)
+	if err != nil {
+		return fmt.Errorf("failed to claim game %s for leaderboard: %w", game.RoomID, err)
+	}
+	if !claimed {
+		return nil
+	}
+
+	weeklyKey := weeklyLeaderboardKey(game.FinishedAt)
+	var increments []repositories.ZIncrement
+	for _, p := range game.Participants {
+		if p.UserID == nil || !p.Won {
+			continue
+		}
+		member := strconv.FormatUint(uint64(*p.UserID), 10)
+
+		for _, key := range []string{
+			LeaderboardKeyPrefix + LeaderboardOverall,
+			LeaderboardKeyPrefix + LeaderboardRole + ":" + p.Role,
+			weeklyKey,
+		} {
+			increments = append(increments, repositories.ZIncrement{Key: key, Member: member, Increment: 1})
+		}
+	}
+	if len(increments) == 0 {
+		return nil
+	}
+
+	err = s.redisRepo.ZIncrByTx(ctx, increments, map[string]time.Duration{weeklyKey: WeeklyLeaderboardTTL})
+	if err != nil {
+		if delErr := s.redisRepo.Del(ctx, recordedKey); delErr != nil {
+			log.Printf("无法释放对局 %s 的排行榜标记: %v", game.RoomID, delErr)
+		}
+		return fmt.Errorf("failed to update leaderboard: %w", err)
+	}
+	return nil
+}
+
+func (s *statsServiceImpl) GetLeaderboard(ctx context.Context, query *dto.LeaderboardQuery) (*dto.LeaderboardResponse, error) {
+	var key string
+	switch query.Board {
+	case LeaderboardOverall:
+		key = LeaderboardKeyPrefix + LeaderboardOverall
+	case LeaderboardRole:
+		if query.Role == "" {
+			return nil, errors.NewAppError(errors.ErrInvalidInput.Code, "role is required for role leaderboard", nil)
+		}
+		key = LeaderboardKeyPrefix + LeaderboardRole + ":" + query.Role
+	case LeaderboardWeekly:
+		key = weeklyLeaderboardKey(time.Now())
+	default:
+		return nil, errors.NewAppError(errors.ErrInvalidInput.Code, "Unknown leaderboard", nil)
+	}
+
+	scores, err := s.redisRepo.ZRevRangeWithScores(ctx, key, 0, int64(query.Limit-1))
+	if err != nil {
+		return nil, errors.NewAppError(errors.ErrInternalError.Code, "Failed to read leaderboard", err)
+	}
+
+	resp := &dto.LeaderboardResponse{
+		Board:   query.Board,
+		Role:    query.Role,
+		Entries: make([]dto.LeaderboardEntry, 0, len(scores)),
+	}
+	userIDs := make([]uint, 0, len(scores))
+	for i, z := range scores {
+		member, _ := z.Member.(string)
+		userID, err := strconv.ParseUint(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		resp.Entries = append(resp.Entries, dto.LeaderboardEntry{
+			Rank:   i + 1,
+			UserID: uint(userID),
+			Wins:   int(z.Score),
+		})
+		userIDs = append(userIDs, uint(userID))
+	}
+
+	// 用户名只用于显示，查询失败时仍返回排行榜
+	users, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		log.Printf("无法查询排行榜用户名: %v", err)
+		return resp, nil
+	}
+	names := make(map[uint]string, len(users))
+	for _, user := range users {
+		names[user.ID] = user.Name
+	}
+	for i := range resp.Entries {
+		resp.Entries[i].Name = names[resp.Entries[i].UserID]
+	}
+	return resp, nil
+}
+
+// GetUserStats 用户战绩，没有对局记录时返回全零的战绩
+func (s *statsServiceImpl) GetUserStats(ctx context.Context, userID uint) (*dto.PlayerStatsResponse, error) {
+	stats, err := s.statsRepo.GetPlayerStats(ctx, userID)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+		stats = &models.PlayerStats{UserID: userID, Rating: models.DefaultRating}
+	}
+
+	roleStats, err := s.statsRepo.GetPlayerRoleStats(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.PlayerStatsResponse{
+		UserID:          userID,
+		Rating:          stats.Rating,
+		GamesPlayed:     stats.GamesPlayed,
+		Wins:            stats.Wins,
+		WinRate:         rate(stats.Wins, stats.GamesPlayed),
+		SurvivalRate:    rate(stats.GamesSurvived, stats.GamesPlayed),
+		CorrectChecks:   stats.CorrectChecks,
+		SuccessfulSaves: stats.SuccessfulSaves,
+		Werewolf: dto.CampStats{
+			Games:   stats.WerewolfGames,
+			Wins:    stats.WerewolfWins,
+			WinRate: rate(stats.WerewolfWins, stats.WerewolfGames),
+		},
+		Villager: dto.CampStats{
+			Games:   stats.VillagerGames,
+			Wins:    stats.VillagerWins,
+			WinRate: rate(stats.VillagerWins, stats.VillagerGames),
+		},
+		Roles: make([]dto.RoleStats, len(roleStats)),
+	}
+	for i, r := range roleStats {
+		resp.Roles[i] = dto.RoleStats{
+			Role:    r.Role,
+			Games:   r.Games,
+			Wins:    r.Wins,
+			WinRate: rate(r.Wins, r.Games),
+		}
+	}
+	return resp, nil
+}
+
+func (s *statsServiceImpl) GetRating(ctx context.Context, playerID string) (int, error) {
+	userID, err := strconv.ParseUint(playerID, 10, 64)
+	if err != nil {
+		return models.DefaultRating, nil
+	}
+
+	stats, err := s.statsRepo.GetPlayerStats(ctx, uint(userID))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return models.DefaultRating, nil
+		}
+		return 0, err
+	}
+	return stats.Rating, nil
+}
+
+// weeklyLeaderboardKey 按 ISO 周划分周榜
+func weeklyLeaderboardKey(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%s%s:%d-%02d", LeaderboardKeyPrefix, LeaderboardWeekly, year, week)
+}
+
+func rate(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dto "liam/internal/dto/werewolf"
+	"liam/internal/models"
+	"liam/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// fakeUsers 记录批量查询次数
+type fakeUsers struct {
+	repositories.UserRepository
+
+	users []models.User
+	calls int
+}
+
+func (f *fakeUsers) GetUsersByIDs(ctx context.Context, ids []uint) ([]models.User, error) {
+	f.calls++
+	return f.users, nil
+}
+
+func TestRecordGame_RetryAfterPartialFailure(t *testing.T) {
+	store := newFakeRedis()
+	store.failTx = 1
+	svc := NewStatsService(nil, store, nil)
+
+	alice, bob := uint(1), uint(2)
+	game := &models.Game{
+		RoomID:     "room-1",
+		FinishedAt: time.Now(),
+		Participants: []models.GameParticipant{
+			{UserID: &alice, Role: "seer", Won: true},
+			{UserID: &bob, Role: "villager", Won: true},
+		},
+	}
+
+	// 第一次写入失败，标记被释放，归档重试时完整计分一次
+	assert.Error(t, svc.RecordGame(context.Background(), game))
+	assert.NotContains(t, store.strings, LeaderboardRecordedKeyPrefix+game.RoomID)
+	assert.Empty(t, store.scores)
+
+	assert.NoError(t, svc.RecordGame(context.Background(), game))
+	// 成功后再次重试不会重复计分
+	assert.NoError(t, svc.RecordGame(context.Background(), game))
+
+	overall := store.scores[LeaderboardKeyPrefix+LeaderboardOverall]
+	assert.Equal(t, 1.0, overall["1"])
+	assert.Equal(t, 1.0, overall["2"])
+	assert.Equal(t, 1.0, store.scores[LeaderboardKeyPrefix+LeaderboardRole+":seer"]["1"])
+	assert.Equal(t, 1.0, store.scores[weeklyLeaderboardKey(game.FinishedAt)]["2"])
+}
+
+func TestGetLeaderboard_LoadsNamesInOneQuery(t *testing.T) {
+	store := newFakeRedis()
+	store.scores[LeaderboardKeyPrefix+LeaderboardOverall] = map[string]float64{"1": 3, "2": 5}
+	users := &fakeUsers{users: []models.User{{Model: gorm.Model{ID: 1}, Name: "alice"}, {Model: gorm.Model{ID: 2}, Name: "bob"}}}
+	svc := NewStatsService(nil, store, users)
+
+	resp, err := svc.GetLeaderboard(context.Background(), &dto.LeaderboardQuery{Board: LeaderboardOverall, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, users.calls)
+	assert.Len(t, resp.Entries, 2)
+	for _, entry := range resp.Entries {
+		assert.NotEmpty(t, entry.Name)
+	}
+}
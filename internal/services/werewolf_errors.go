@@ -0,0 +1,150 @@
+package services
+
+import (
+	"net/http"
+
+	dto "liam/internal/dto/werewolf"
+	"liam/pkg/errors"
+	pb "liam/pkg/werewolf/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// --- 游戏业务错误码 ---
+// 通用错误码见 pkg/errors，游戏相关的错误从 2001 开始
+
+var (
+	ErrRoomNotFound      = errors.NewAppError(2001, "Room not found", nil)
+	ErrPlayerNotFound    = errors.NewAppError(2002, "Player not found", nil)
+	ErrRoomFull          = errors.NewAppError(2003, "Room is full", nil)
+	ErrGameStarted       = errors.NewAppError(2004, "Game already started", nil)
+	ErrWrongPhase        = errors.NewAppError(2005, "Not allowed in the current phase", nil)
+	ErrNotYourTurn       = errors.NewAppError(2006, "Not your turn", nil)
+	ErrInvalidTarget     = errors.NewAppError(2007, "Invalid target", nil)
+	ErrAlreadyVoted      = errors.NewAppError(2008, "Already voted", nil)
+	ErrActionNotAllowed  = errors.NewAppError(2009, "Action not allowed", nil)
+	ErrInvalidMessage    = errors.NewAppError(2010, "Invalid chat message", nil)
+	ErrRateLimited       = errors.NewAppError(2011, "Too many requests", nil)
+	ErrInvalidRoleConfig = errors.NewAppError(2012, "Invalid role config", nil)
+	ErrGameUnavailable   = errors.NewAppError(2013, "Game service unavailable", nil)
+)
+
+// reasonErrors 游戏服务返回的错误原因对应的错误码
+var reasonErrors = map[pb.ErrorReason]*errors.AppError{
+	pb.ErrorReason_ERROR_REASON_ROOM_NOT_FOUND:       ErrRoomNotFound,
+	pb.ErrorReason_ERROR_REASON_PLAYER_NOT_FOUND:     ErrPlayerNotFound,
+	pb.ErrorReason_ERROR_REASON_ROOM_FULL:            ErrRoomFull,
+	pb.ErrorReason_ERROR_REASON_GAME_ALREADY_STARTED: ErrGameStarted,
+	pb.ErrorReason_ERROR_REASON_WRONG_PHASE:          ErrWrongPhase,
+	pb.ErrorReason_ERROR_REASON_NOT_YOUR_TURN:        ErrNotYourTurn,
+	pb.ErrorReason_ERROR_REASON_INVALID_TARGET:       ErrInvalidTarget,
+	pb.ErrorReason_ERROR_REASON_ALREADY_VOTED:        ErrAlreadyVoted,
+	pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED:   ErrActionNotAllowed,
+	pb.ErrorReason_ERROR_REASON_INVALID_MESSAGE:      ErrInvalidMessage,
+	pb.ErrorReason_ERROR_REASON_RATE_LIMITED:         ErrRateLimited,
+	pb.ErrorReason_ERROR_REASON_INVALID_ROLE_CONFIG:  ErrInvalidRoleConfig,
+}
+
+// codeErrors 没有错误原因时按 gRPC 状态码归类，未列出的状态码视为内部错误
+var codeErrors = map[codes.Code]*errors.AppError{
+	codes.NotFound:           errors.ErrNotFound,
+	codes.InvalidArgument:    errors.ErrInvalidInput,
+	codes.AlreadyExists:      errors.ErrConflict,
+	codes.FailedPrecondition: errors.ErrConflict,
+	codes.PermissionDenied:   errors.ErrForbidden,
+	codes.Unauthenticated:    errors.ErrUnauthorized,
+	codes.ResourceExhausted:  ErrRateLimited,
+	codes.Unavailable:        ErrGameUnavailable,
+	codes.DeadlineExceeded:   ErrGameUnavailable,
+}
+
+// httpError 错误码对应的 HTTP 状态码和 ErrorResponse.Error 中的错误名
+type httpError struct {
+	status int
+	name   string
+}
+
+var httpErrors = map[int]httpError{
+	errors.ErrNotFound.Code:      {http.StatusNotFound, "not_found"},
+	errors.ErrInvalidInput.Code:  {http.StatusBadRequest, "invalid_request"},
+	errors.ErrInternalError.Code: {http.StatusInternalServerError, "service_error"},
+	errors.ErrConflict.Code:      {http.StatusConflict, "conflict"},
+	errors.ErrUnauthorized.Code:  {http.StatusUnauthorized, "unauthorized"},
+	errors.ErrForbidden.Code:     {http.StatusForbidden, "forbidden"},
+
+	ErrRoomNotFound.Code:      {http.StatusNotFound, "room_not_found"},
+	ErrPlayerNotFound.Code:    {http.StatusNotFound, "player_not_found"},
+	ErrRoomFull.Code:          {http.StatusConflict, "room_full"},
+	ErrGameStarted.Code:       {http.StatusConflict, "game_already_started"},
+	ErrWrongPhase.Code:        {http.StatusConflict, "wrong_phase"},
+	ErrNotYourTurn.Code:       {http.StatusConflict, "not_your_turn"},
+	ErrInvalidTarget.Code:     {http.StatusUnprocessableEntity, "invalid_target"},
+	ErrAlreadyVoted.Code:      {http.StatusConflict, "already_voted"},
+	ErrActionNotAllowed.Code:  {http.StatusForbidden, "action_not_allowed"},
+	ErrInvalidMessage.Code:    {http.StatusUnprocessableEntity, "invalid_message"},
+	ErrRateLimited.Code:       {http.StatusTooManyRequests, "rate_limited"},
+	ErrInvalidRoleConfig.Code: {http.StatusUnprocessableEntity, "invalid_role_config"},
+	ErrGameUnavailable.Code:   {http.StatusServiceUnavailable, "service_unavailable"},
+}
+
+// GameError 将游戏服务返回的 gRPC 错误转换为 AppError，优先按错误详情中的原因归类，其次按状态码
+// 消息使用游戏服务返回的提示，原始错误保留在 Err 中；已经是 AppError 或不是 gRPC 错误时原样返回
+func GameError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var appErr *errors.AppError
+	if errors.AsAppError(err, &appErr) {
+		return err
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	template, ok := codeErrors[st.Code()]
+	if !ok {
+		template = errors.ErrInternalError
+	}
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*pb.ErrorDetail); ok {
+			if reasonErr, ok := reasonErrors[d.Reason]; ok {
+				template = reasonErr
+			}
+		}
+	}
+	return errors.NewAppError(template.Code, st.Message(), err)
+}
+
+// rejected 将游戏服务 success=false 的响应转换为 AppError，没有原因时按冲突处理
+func rejected(reason pb.ErrorReason, message string) error {
+	template, ok := reasonErrors[reason]
+	if !ok {
+		template = errors.ErrConflict
+	}
+	return errors.NewAppError(template.Code, message, nil)
+}
+
+// ErrorResponse 返回错误对应的 HTTP 状态码和响应体，未知错误按内部错误处理
+func ErrorResponse(err error) (int, dto.ErrorResponse) {
+	err = GameError(err)
+
+	appErr := errors.ErrInternalError
+	message := err.Error()
+	var target *errors.AppError
+	if errors.AsAppError(err, &target) {
+		appErr, message = target, target.Message
+	}
+
+	mapped, ok := httpErrors[appErr.Code]
+	if !ok {
+		mapped = httpErrors[errors.ErrInternalError.Code]
+	}
+	return mapped.status, dto.ErrorResponse{
+		Success: false,
+		Error:   mapped.name,
+		Code:    appErr.Code,
+		Message: message,
+	}
+}
@@ -5,15 +5,21 @@ import (
 	"fmt"
 	"liam/internal/client"
 	dto "liam/internal/dto/werewolf"
+	"liam/internal/models"
+	"liam/pkg/errors"
+	pb "liam/pkg/werewolf/v1"
+	"liam/repositories"
 )
 
 type WerewolfService struct {
 	grpcClient *client.WerewolfGRPCClient
+	gameRepo   repositories.GameRepository
 }
 
-func NewWerewolfService(grpcClient *client.WerewolfGRPCClient) *WerewolfService {
+func NewWerewolfService(grpcClient *client.WerewolfGRPCClient, gameRepo repositories.GameRepository) *WerewolfService {
 	return &WerewolfService{
 		grpcClient: grpcClient,
+		gameRepo:   gameRepo,
 	}
 }
 
@@ -29,9 +35,37 @@ func (s *WerewolfService) CreateRoom(ctx context.Context, req *dto.CreateRoomReq
 		roleConfig[k] = int32(v)
 	}
 
-	resp, err := s.grpcClient.CreateRoom(ctx, req.RoomName, int32(req.MaxPlayers), roleConfig)
+	visibility := &pb.VisibilityConfig{
+		DeadGodView:      req.DeadGodView,
+		SpectatorGodView: req.SpectatorGodView,
+	}
+
+	timeoutPolicy := &pb.TimeoutPolicy{
+		WerewolfRandomKill: req.WerewolfRandomKill,
+		VoteRandomTarget:   req.VoteRandomTarget,
+		SeerRandomCheck:    req.SeerRandomCheck,
+		AfkThreshold:       int32(req.AfkThreshold),
+		BotTakeover:        req.BotTakeover,
+	}
+
+	voteMode := pb.VoteMode_VOTE_MODE_OPEN
+	if req.AnonymousVote {
+		voteMode = pb.VoteMode_VOTE_MODE_ANONYMOUS
+	}
+
+	resp, err := s.grpcClient.CreateRoom(ctx, &pb.CreateRoomRequest{
+		RoomName:       req.RoomName,
+		MaxPlayers:     int32(req.MaxPlayers),
+		RoleConfig:     roleConfig,
+		Visibility:     visibility,
+		TimeoutPolicy:  timeoutPolicy,
+		VoteMode:       voteMode,
+		StrictSpeaking: req.StrictSpeaking,
+		CreatorId:      req.CreatorID,
+		RandomLovers:   req.RandomLovers,
+	})
 	if err != nil {
-		return nil, err
+		return nil, GameError(err)
 	}
 
 	return &dto.CreateRoomResponse{
@@ -42,24 +76,53 @@ func (s *WerewolfService) CreateRoom(ctx context.Context, req *dto.CreateRoomReq
 
 // JoinRoom 加入房间
 func (s *WerewolfService) JoinRoom(ctx context.Context, req *dto.JoinRoomRequest) (*dto.JoinRoomResponse, error) {
-	resp, err := s.grpcClient.JoinRoom(ctx, req.RoomID, req.PlayerID, req.PlayerName)
+	resp, err := s.grpcClient.JoinRoom(ctx, req.RoomID, req.PlayerID, req.PlayerName, req.Spectate, req.GodView)
 	if err != nil {
-		return nil, err
+		return nil, GameError(err)
+	}
+	if !resp.Success {
+		return nil, rejected(resp.Reason, resp.Message)
 	}
 
 	return &dto.JoinRoomResponse{
 		Success:  resp.Success,
 		Message:  resp.Message,
 		PlayerID: req.PlayerID,
-		Position: resp.Player.Position,
+		Position: resp.GetPlayer().GetPosition(),
 	}, nil
 }
 
+// ListRooms 大厅房间列表
+func (s *WerewolfService) ListRooms(ctx context.Context, includeFinished bool) (*dto.ListRoomsResponse, error) {
+	resp, err := s.grpcClient.ListRooms(ctx, includeFinished)
+	if err != nil {
+		return nil, GameError(err)
+	}
+
+	rooms := make([]dto.RoomSummary, len(resp.Rooms))
+	for i, r := range resp.Rooms {
+		rooms[i] = dto.RoomSummary{
+			RoomID:         r.RoomId,
+			RoomName:       r.RoomName,
+			State:          r.State.String(),
+			PlayerCount:    r.PlayerCount,
+			MaxPlayers:     r.MaxPlayers,
+			SpectatorCount: r.SpectatorCount,
+			DayCount:       r.DayCount,
+		}
+	}
+
+	return &dto.ListRoomsResponse{Rooms: rooms}, nil
+}
+
 // StartGame 开始游戏
 func (s *WerewolfService) StartGame(ctx context.Context, req *dto.StartGameRequest) (*dto.StartGameResponse, error) {
-	resp, err := s.grpcClient.StartGame(ctx, req.RoomID)
+	resp, err := s.grpcClient.StartGame(ctx, req.RoomID, req.PlayerID)
 	if err != nil {
-		return nil, err
+		return nil, GameError(err)
+	}
+	if !resp.Success {
+		return nil, rejected(resp.Reason, resp.Message)
 	}
 
 	var phaseInfo *dto.PhaseInfo
@@ -80,23 +143,79 @@ func (s *WerewolfService) StartGame(ctx context.Context, req *dto.StartGameReque
 
 // NightAction 夜晚行动
 func (s *WerewolfService) NightAction(ctx context.Context, req *dto.NightActionRequest) (*dto.NightActionResponse, error) {
-	resp, err := s.grpcClient.NightAction(ctx, req.RoomID, req.PlayerID, req.TargetID, req.ActionType)
+	action := pb.ParseActionType(req.ActionType)
+	if action == pb.ActionType_ACTION_UNKNOWN {
+		return nil, errors.NewAppError(errors.ErrInvalidInput.Code, fmt.Sprintf("未知的行动类型: %s", req.ActionType), nil)
+	}
+
+	resp, err := s.grpcClient.NightAction(ctx, req.RoomID, req.PlayerID, req.TargetID, action)
 	if err != nil {
-		return nil, err
+		return nil, GameError(err)
+	}
+	if !resp.Success {
+		return nil, rejected(resp.Reason, resp.Message)
+	}
+
+	var seerResult *dto.SeerResult
+	if resp.SeerResult != nil {
+		seerResult = &dto.SeerResult{
+			TargetID: resp.SeerResult.TargetId,
+			Camp:     resp.SeerResult.Camp.String(),
+			Day:      resp.SeerResult.Day,
+		}
 	}
 
 	return &dto.NightActionResponse{
+		Success:    resp.Success,
+		Message:    resp.Message,
+		Result:     resp.Result,
+		SeerResult: seerResult,
+	}, nil
+}
+
+// chatChannels 聊天频道名称
+var chatChannels = map[string]pb.ChatChannel{
+	"public":    pb.ChatChannel_CHAT_CHANNEL_PUBLIC,
+	"werewolf":  pb.ChatChannel_CHAT_CHANNEL_WEREWOLF,
+	"dead":      pb.ChatChannel_CHAT_CHANNEL_DEAD,
+	"spectator": pb.ChatChannel_CHAT_CHANNEL_SPECTATOR,
+}
+
+// ParseChatChannel 解析聊天频道名称，如 "werewolf"
+func ParseChatChannel(name string) (pb.ChatChannel, bool) {
+	channel, ok := chatChannels[name]
+	return channel, ok
+}
+
+// SendChat 发送聊天消息
+func (s *WerewolfService) SendChat(ctx context.Context, req *dto.ChatRequest) (*dto.ChatResponse, error) {
+	channel, ok := ParseChatChannel(req.Channel)
+	if !ok {
+		return nil, errors.NewAppError(errors.ErrInvalidInput.Code, fmt.Sprintf("未知的聊天频道: %s", req.Channel), nil)
+	}
+
+	resp, err := s.grpcClient.SendChatMessage(ctx, req.RoomID, req.PlayerID, channel, req.Content)
+	if err != nil {
+		return nil, GameError(err)
+	}
+	if !resp.Success {
+		return nil, rejected(resp.Reason, resp.Message)
+	}
+
+	return &dto.ChatResponse{
 		Success: resp.Success,
 		Message: resp.Message,
-		Result:  resp.Result,
 	}, nil
 }
 
 // Vote 投票
 func (s *WerewolfService) Vote(ctx context.Context, req *dto.VoteRequest) (*dto.VoteResponse, error) {
-	resp, err := s.grpcClient.Vote(ctx, req.RoomID, req.VoterID, req.TargetID)
+	resp, err := s.grpcClient.Vote(ctx, req.RoomID, req.VoterID, req.TargetID, req.Abstain)
 	if err != nil {
-		return nil, err
+		return nil, GameError(err)
+	}
+	if !resp.Success {
+		return nil, rejected(resp.Reason, resp.Message)
 	}
 
 	return &dto.VoteResponse{
@@ -106,35 +225,20 @@ func (s *WerewolfService) Vote(ctx context.Context, req *dto.VoteRequest) (*dto.
 }
 
 // GetGameState 获取游戏状态
-func (s *WerewolfService) GetGameState(ctx context.Context, roomID, playerID string) (*dto.GetGameStateResponse, error) {
-	resp, err := s.grpcClient.GetGameState(ctx, roomID, playerID)
+func (s *WerewolfService) GetGameState(ctx context.Context, roomID, playerID string, spectator bool) (*dto.GetGameStateResponse, error) {
+	resp, err := s.grpcClient.GetGameState(ctx, roomID, playerID, spectator)
 	if err != nil {
-		return nil, err
+		return nil, GameError(err)
 	}
 	players := make([]dto.PlayerInfo, len(resp.Players))
 	for i, p := range resp.Players {
-		players[i] = dto.PlayerInfo{
-			PlayerID: p.PlayerId,
-			Name:     p.Name,
-			Role:     p.Role.String(),
-			Camp:     p.Camp.String(),
-			IsAlive:  p.IsAlive,
-			Position: p.Position,
-			CanAct:   p.CanAct,
-		}
+		players[i] = ToPlayerInfo(p)
 	}
 
 	var currentPlayer *dto.PlayerInfo
 	if resp.CurrentPlayer != nil {
-		currentPlayer = &dto.PlayerInfo{
-			PlayerID: resp.CurrentPlayer.PlayerId,
-			Name:     resp.CurrentPlayer.Name,
-			Role:     resp.CurrentPlayer.Role.String(),
-			Camp:     resp.CurrentPlayer.Camp.String(),
-			IsAlive:  resp.CurrentPlayer.IsAlive,
-			Position: resp.CurrentPlayer.Position,
-			CanAct:   resp.CurrentPlayer.CanAct,
-		}
+		info := ToPlayerInfo(resp.CurrentPlayer)
+		currentPlayer = &info
 	}
 
 	var phaseInfo *dto.PhaseInfo
@@ -153,9 +257,261 @@ func (s *WerewolfService) GetGameState(ctx context.Context, roomID, playerID str
 		Players:       players,
 		DayCount:      int(resp.DayCount),
 		CurrentPlayer: currentPlayer,
+		Knowledge:     toPrivateKnowledge(resp.Knowledge),
+		VoteHistory:   toVoteHistory(resp.VoteHistory),
 	}, nil
 }
 
+// ToPlayerInfo 转换玩家信息
+// 游戏服务已按可见性规则过滤，身份未知（UNKNOWN）时不输出 role/camp 字段
+func ToPlayerInfo(p *pb.Player) dto.PlayerInfo {
+	info := dto.PlayerInfo{
+		PlayerID: p.PlayerId,
+		Name:     p.Name,
+		IsAlive:  p.IsAlive,
+		Position: p.Position,
+		CanAct:   p.CanAct,
+
+		IsAfk:         p.IsAfk,
+		BotControlled: p.BotControlled,
+		Ready:         p.Ready,
+		Presence:      presenceName(p.Presence),
+		LastSeen:      p.LastSeen,
+	}
+	if p.Role != pb.Role_UNKNOWN {
+		info.Role = p.Role.String()
+	}
+	if p.Camp != pb.Camp_CAMP_UNKNOWN {
+		info.Camp = p.Camp.String()
+	}
+	return info
+}
+
+// presenceName 转换玩家在线状态，未知状态返回空字符串
+func presenceName(presence pb.PresenceStatus) string {
+	switch presence {
+	case pb.PresenceStatus_PRESENCE_ONLINE:
+		return "online"
+	case pb.PresenceStatus_PRESENCE_RECONNECTING:
+		return "reconnecting"
+	case pb.PresenceStatus_PRESENCE_OFFLINE:
+		return "offline"
+	}
+	return ""
+}
+
+// toPrivateKnowledge 转换玩家私有信息
+func toPrivateKnowledge(k *pb.PrivateKnowledge) *dto.PrivateKnowledge {
+	if k == nil {
+		return nil
+	}
+
+	result := &dto.PrivateKnowledge{LoverID: k.LoverId}
+	for _, c := range k.SeerChecks {
+		result.SeerChecks = append(result.SeerChecks, dto.SeerResult{
+			TargetID: c.TargetId,
+			Camp:     c.Camp.String(),
+			Day:      c.Day,
+		})
+	}
+	for _, p := range k.WitchPotions {
+		result.WitchPotions = append(result.WitchPotions, dto.WitchPotionRecord{
+			Day:      p.Day,
+			Action:   p.Action.LegacyName(),
+			TargetID: p.TargetId,
+		})
+	}
+	for _, g := range k.GuardRecords {
+		result.GuardRecords = append(result.GuardRecords, dto.GuardRecord{
+			Day:      g.Day,
+			TargetID: g.TargetId,
+		})
+	}
+	return result
+}
+
+// ListGameHistory 分页查询已归档的对局
+func (s *WerewolfService) ListGameHistory(ctx context.Context, query *dto.GameHistoryQuery) (*dto.GameHistoryListResponse, error) {
+	offset := (query.Page - 1) * query.PageSize
+	games, total, err := s.gameRepo.ListGames(ctx, query.PlayerID, offset, query.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.GameHistoryListResponse{
+		Games:    make([]dto.GameHistory, len(games)),
+		Total:    total,
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	}
+	for i := range games {
+		result.Games[i] = toGameHistory(&games[i])
+	}
+	return result, nil
+}
+
+// GetGameHistory 查询单局归档记录，包括完整时间线
+func (s *WerewolfService) GetGameHistory(ctx context.Context, roomID string) (*dto.GameHistory, error) {
+	game, err := s.gameRepo.GetGameByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := toGameHistory(game)
+	result.Events = make([]dto.GameHistoryEvent, len(game.Events))
+	for i, e := range game.Events {
+		result.Events[i] = dto.GameHistoryEvent{
+			Day:         e.Day,
+			Phase:       e.Phase,
+			ActorID:     e.ActorID,
+			Action:      e.Action,
+			TargetID:    e.TargetID,
+			DeathCause:  e.DeathCause,
+			Description: e.Description,
+			Timestamp:   e.OccurredAt.Unix(),
+		}
+	}
+	return &result, nil
+}
+
+// toGameHistory 转换归档对局，不包括时间线
+func toGameHistory(game *models.Game) dto.GameHistory {
+	history := dto.GameHistory{
+		RoomID:       game.RoomID,
+		RoomName:     game.RoomName,
+		Winner:       game.Winner,
+		DayCount:     game.DayCount,
+		StartedAt:    game.StartedAt.Unix(),
+		FinishedAt:   game.FinishedAt.Unix(),
+		Participants: make([]dto.GameParticipant, len(game.Participants)),
+	}
+	for i, p := range game.Participants {
+		history.Participants[i] = dto.GameParticipant{
+			PlayerID:   p.PlayerID,
+			UserID:     p.UserID,
+			Name:       p.Name,
+			Position:   p.Position,
+			Role:       p.Role,
+			Camp:       p.Camp,
+			Won:        p.Won,
+			Survived:   p.Survived,
+			DeathCause: p.DeathCause,
+			DeathDay:   p.DeathDay,
+		}
+	}
+	return history
+}
+
+// ToQueueUpdate 转换匹配状态
+func ToQueueUpdate(update *pb.QueueUpdate) dto.QueueUpdate {
+	return dto.QueueUpdate{
+		Status:               update.Status.String(),
+		Board:                update.Board,
+		Position:             update.Position,
+		QueueSize:            update.QueueSize,
+		EstimatedWaitSeconds: update.EstimatedWaitSeconds,
+		Rating:               update.Rating,
+		RoomID:               update.RoomId,
+	}
+}
+
+// toVoteHistory 转换历次投票结果
+func toVoteHistory(history []*pb.VoteTally) []dto.VoteTally {
+	result := make([]dto.VoteTally, len(history))
+	for i, t := range history {
+		result[i] = dto.VoteTally{
+			Day:          t.Day,
+			Counts:       t.Counts,
+			EliminatedID: t.EliminatedId,
+			Abstentions:  t.Abstentions,
+		}
+		for _, b := range t.Ballots {
+			result[i].Ballots = append(result[i].Ballots, dto.Ballot{
+				VoterID:  b.VoterId,
+				TargetID: b.TargetId,
+				Abstain:  b.Abstain,
+			})
+		}
+	}
+	return result
+}
+
+// GetGameReport 获取复盘报告
+func (s *WerewolfService) GetGameReport(ctx context.Context, roomID string) (*dto.GameReportResponse, error) {
+	resp, err := s.grpcClient.GetGameReport(ctx, roomID)
+	if err != nil {
+		return nil, GameError(err)
+	}
+
+	report := resp.Report
+	result := &dto.GameReportResponse{
+		RoomID:     report.RoomId,
+		RoomName:   report.RoomName,
+		Winner:     report.Winner.String(),
+		DayCount:   report.DayCount,
+		StartedAt:  report.StartedAt,
+		FinishedAt: report.FinishedAt,
+		Players:    make([]dto.PlayerReveal, len(report.Players)),
+		Timeline:   make([]dto.TimelineEntry, len(report.Timeline)),
+		Votes:      toVoteHistory(report.Votes),
+	}
+	for i, p := range report.Players {
+		result.Players[i] = dto.PlayerReveal{
+			PlayerInfo: ToPlayerInfo(p.Player),
+			DeathDay:   p.DeathDay,
+		}
+		if p.DeathCause != pb.DeathCause_DEATH_CAUSE_NONE {
+			result.Players[i].DeathCause = p.DeathCause.String()
+		}
+	}
+	for i, e := range report.Timeline {
+		result.Timeline[i] = dto.TimelineEntry{
+			Day:         e.Day,
+			Phase:       e.Phase.String(),
+			ActorID:     e.ActorId,
+			Action:      e.Action.LegacyName(),
+			TargetID:    e.TargetId,
+			Description: e.Description,
+			Timestamp:   e.Timestamp,
+		}
+		if e.DeathCause != pb.DeathCause_DEATH_CAUSE_NONE {
+			result.Timeline[i].DeathCause = e.DeathCause.String()
+		}
+	}
+	return result, nil
+}
+
+// GetAvailableActions 获取玩家当前可执行的行动
+func (s *WerewolfService) GetAvailableActions(ctx context.Context, roomID, playerID string) (*dto.AvailableActionsResponse, error) {
+	resp, err := s.grpcClient.GetAvailableActions(ctx, roomID, playerID)
+	if err != nil {
+		return nil, GameError(err)
+	}
+
+	return &dto.AvailableActionsResponse{
+		RoomID:   resp.RoomId,
+		PlayerID: resp.PlayerId,
+		Phase:    resp.Phase.String(),
+		Actions:  ToAvailableActions(resp.Actions),
+		Deadline: resp.Deadline,
+	}, nil
+}
+
+// ToAvailableActions 转换可执行行动列表
+func ToAvailableActions(actions []*pb.AvailableAction) []dto.AvailableAction {
+	result := make([]dto.AvailableAction, len(actions))
+	for i, a := range actions {
+		result[i] = dto.AvailableAction{
+			ActionType:     a.Action.LegacyName(),
+			TargetIDs:      a.TargetIds,
+			RequiresTarget: a.RequiresTarget,
+			RemainingUses:  a.RemainingUses,
+			Description:    a.Description,
+		}
+	}
+	return result
+}
+
 // validateRoleConfig 验证角色配置
 func (s *WerewolfService) validateRoleConfig(config map[string]int, maxPlayers int) error {
 	totalPlayers := 0
@@ -163,17 +519,17 @@ func (s *WerewolfService) validateRoleConfig(config map[string]int, maxPlayers i
 		totalPlayers += count
 	}
 	if totalPlayers != maxPlayers {
-		return fmt.Errorf("角色数量(%d)与最大玩家数(%d)不匹配", totalPlayers, maxPlayers)
+		return errors.NewAppError(ErrInvalidRoleConfig.Code, fmt.Sprintf("角色数量(%d)与最大玩家数(%d)不匹配", totalPlayers, maxPlayers), nil)
 	}
 
 	// 至少要有1个狼人
 	if config["werewolf"] < 1 {
-		return fmt.Errorf("至少需要1个狼人")
+		return errors.NewAppError(ErrInvalidRoleConfig.Code, "至少需要1个狼人", nil)
 	}
 
 	// 至少要有1个村民
 	if config["villager"] < 1 {
-		return fmt.Errorf("至少需要1个村民")
+		return errors.NewAppError(ErrInvalidRoleConfig.Code, "至少需要1个村民", nil)
 	}
 
 	return nil
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	dto "liam/internal/dto/werewolf"
+	"liam/pkg/errors"
+	"liam/repositories"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+const WSTicketKeyPrefix = "werewolf:ws_ticket:"
+
+// WSTicket 凭证对应的登录身份
+type WSTicket struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+}
+
+// WSTicketService 签发和兑换 WebSocket 连接凭证
+// 浏览器无法为 WebSocket 握手设置 Authorization 头，客户端先通过已鉴权的接口换取短期凭证再发起连接
+type WSTicketService interface {
+	Issue(ctx context.Context, ticket WSTicket) (*dto.WSTicketResponse, error)
+	// Redeem 兑换凭证，每个凭证只能使用一次
+	Redeem(ctx context.Context, ticket string) (*WSTicket, error)
+}
+
+type wsTicketServiceImpl struct {
+	redisRepo repositories.RedisRepository
+	ttl       time.Duration
+}
+
+func NewWSTicketService(redisRepo repositories.RedisRepository, ttl time.Duration) WSTicketService {
+	return &wsTicketServiceImpl{
+		redisRepo: redisRepo,
+		ttl:       ttl,
+	}
+}
+
+func (s *wsTicketServiceImpl) Issue(ctx context.Context, ticket WSTicket) (*dto.WSTicketResponse, error) {
+	value, err := json.Marshal(ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.NewString()
+	if err := s.redisRepo.Set(ctx, WSTicketKeyPrefix+id, value, s.ttl); err != nil {
+		return nil, fmt.Errorf("failed to store websocket ticket: %w", err)
+	}
+
+	return &dto.WSTicketResponse{
+		Success:   true,
+		Ticket:    id,
+		ExpiresIn: int64(s.ttl / time.Second),
+	}, nil
+}
+
+func (s *wsTicketServiceImpl) Redeem(ctx context.Context, ticket string) (*WSTicket, error) {
+	value, err := s.redisRepo.GetDel(ctx, WSTicketKeyPrefix+ticket)
+	if err == redis.Nil {
+		return nil, errors.NewAppError(errors.ErrUnauthorized.Code, "websocket ticket not found or expired", nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to redeem websocket ticket: %w", err)
+	}
+
+	var t WSTicket
+	if err := json.Unmarshal([]byte(value), &t); err != nil {
+		return nil, fmt.Errorf("invalid websocket ticket: %w", err)
+	}
+	return &t, nil
+}
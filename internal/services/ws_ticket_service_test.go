@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"liam/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWSTicket_Redeem(t *testing.T) {
+	tests := []struct {
+		name    string
+		redeem  func(svc WSTicketService, store *fakeRedis, ticket string) (*WSTicket, error)
+		wantErr bool
+	}{
+		{
+			name: "有效凭证",
+			redeem: func(svc WSTicketService, store *fakeRedis, ticket string) (*WSTicket, error) {
+				return svc.Redeem(context.Background(), ticket)
+			},
+		},
+		{
+			name: "凭证只能使用一次",
+			redeem: func(svc WSTicketService, store *fakeRedis, ticket string) (*WSTicket, error) {
+				if _, err := svc.Redeem(context.Background(), ticket); err != nil {
+					return nil, err
+				}
+				return svc.Redeem(context.Background(), ticket)
+			},
+			wantErr: true,
+		},
+		{
+			name: "凭证过期",
+			redeem: func(svc WSTicketService, store *fakeRedis, ticket string) (*WSTicket, error) {
+				expired := time.Now().Add(time.Minute + time.Second)
+				store.now = func() time.Time { return expired }
+				return svc.Redeem(context.Background(), ticket)
+			},
+			wantErr: true,
+		},
+		{
+			name: "未知凭证",
+			redeem: func(svc WSTicketService, store *fakeRedis, ticket string) (*WSTicket, error) {
+				return svc.Redeem(context.Background(), "unknown")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeRedis()
+			svc := NewWSTicketService(store, time.Minute)
+
+			issued, err := svc.Issue(context.Background(), WSTicket{PlayerID: "7", PlayerName: "alice"})
+			assert.NoError(t, err)
+			assert.Equal(t, int64(60), issued.ExpiresIn)
+
+			ticket, err := tt.redeem(svc, store, issued.Ticket)
+			if tt.wantErr {
+				assert.True(t, errors.IsUnauthorized(err), "%v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &WSTicket{PlayerID: "7", PlayerName: "alice"}, ticket)
+		})
+	}
+}
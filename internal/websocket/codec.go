@@ -0,0 +1,163 @@
+package websocket
+
+import (
+	"encoding/json"
+	"errors"
+	"liam/internal/services"
+	pb "liam/pkg/werewolf/v1"
+	"log"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// 消息编码的子协议，客户端在 Sec-WebSocket-Protocol 中协商，可与 bearer 同时提供
+// 未协商时使用 messages.go 中的 JSON 消息
+const (
+	ProtocolJSON     = "werewolf.v1.json"  // ClientFrame / ServerFrame 的 protojson 编码，文本帧
+	ProtocolProtobuf = "werewolf.v1.proto" // ClientFrame / ServerFrame 的 protobuf 编码，二进制帧
+)
+
+// codec 连接协商的编码方式，服务端内部统一使用 ServerFrame，由 codec 转换为线上格式
+type codec interface {
+	decode(messageType int, data []byte) (*pb.ClientFrame, *frameError)
+	encode(frame *pb.ServerFrame) outbound
+}
+
+func codecFor(subprotocol string) codec {
+	switch subprotocol {
+	case ProtocolJSON:
+		return protojsonCodec{}
+	case ProtocolProtobuf:
+		return protobufCodec{}
+	}
+	return jsonCodec{}
+}
+
+// frameError 无法处理的客户端消息，以 error 回复
+type frameError struct {
+	requestID string
+	code      string
+	message   string
+}
+
+// jsonCodec 兼容旧客户端的 JSON 消息，游戏规则拒绝的请求转换为 error
+type jsonCodec struct{}
+
+func (jsonCodec) decode(_ int, data []byte) (*pb.ClientFrame, *frameError) {
+	var msg ClientMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, &frameError{code: ErrCodeInvalidMessage, message: err.Error()}
+	}
+
+	if msg.Type == TypePing {
+		return &pb.ClientFrame{Frame: &pb.ClientFrame_Ping{Ping: &pb.GatewayPing{RequestId: msg.RequestID}}}, nil
+	}
+
+	req, err := sessionRequest(msg)
+	if errors.Is(err, errUnknownType) {
+		return nil, &frameError{requestID: msg.RequestID, code: ErrCodeUnknownType, message: "unknown message type: " + msg.Type}
+	}
+	if err != nil {
+		return nil, &frameError{requestID: msg.RequestID, code: ErrCodeInvalidMessage, message: err.Error()}
+	}
+	return &pb.ClientFrame{Frame: &pb.ClientFrame_Request{Request: req}}, nil
+}
+
+func (jsonCodec) encode(frame *pb.ServerFrame) outbound {
+	var msg ServerMessage
+	switch f := frame.Frame.(type) {
+	case *pb.ServerFrame_Session:
+		if event := f.Session.GetEvent(); event != nil {
+			msg = eventMessage(event)
+		} else {
+			msg = resultMessage(f.Session)
+		}
+	case *pb.ServerFrame_QueueUpdate:
+		msg = ServerMessage{
+			Type:    TypeQueueUpdate,
+			Payload: map[string]interface{}{"queue": services.ToQueueUpdate(f.QueueUpdate)},
+		}
+	case *pb.ServerFrame_Pong:
+		msg = ServerMessage{
+			Type:      TypePong,
+			RequestID: f.Pong.RequestId,
+			Payload:   map[string]interface{}{"timestamp": f.Pong.Timestamp},
+		}
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("Error marshaling message: %v", err)
+	}
+	return outbound{data: data}
+}
+
+// protojsonCodec 直接使用 proto 消息的 JSON 编码，不丢失字段
+type protojsonCodec struct{}
+
+func (protojsonCodec) decode(messageType int, data []byte) (*pb.ClientFrame, *frameError) {
+	if messageType != websocket.TextMessage {
+		return nil, &frameError{code: ErrCodeInvalidMessage, message: ProtocolJSON + " expects text frames"}
+	}
+	var frame pb.ClientFrame
+	if err := protojson.Unmarshal(data, &frame); err != nil {
+		return nil, &frameError{code: ErrCodeInvalidMessage, message: err.Error()}
+	}
+	return &frame, nil
+}
+
+func (protojsonCodec) encode(frame *pb.ServerFrame) outbound {
+	data, err := protojson.Marshal(frame)
+	if err != nil {
+		log.Printf("Error marshaling frame: %v", err)
+	}
+	return outbound{data: data}
+}
+
+type protobufCodec struct{}
+
+func (protobufCodec) decode(messageType int, data []byte) (*pb.ClientFrame, *frameError) {
+	if messageType != websocket.BinaryMessage {
+		return nil, &frameError{code: ErrCodeInvalidMessage, message: ProtocolProtobuf + " expects binary frames"}
+	}
+	var frame pb.ClientFrame
+	if err := proto.Unmarshal(data, &frame); err != nil {
+		return nil, &frameError{code: ErrCodeInvalidMessage, message: err.Error()}
+	}
+	return &frame, nil
+}
+
+func (protobufCodec) encode(frame *pb.ServerFrame) outbound {
+	data, err := proto.Marshal(frame)
+	if err != nil {
+		log.Printf("Error marshaling frame: %v", err)
+	}
+	return outbound{data: data, binary: true}
+}
+
+func sessionFrame(resp *pb.SessionResponse) *pb.ServerFrame {
+	return &pb.ServerFrame{Frame: &pb.ServerFrame_Session{Session: resp}}
+}
+
+func eventFrame(event *pb.GameEvent) *pb.ServerFrame {
+	return sessionFrame(&pb.SessionResponse{Response: &pb.SessionResponse_Event{Event: event}})
+}
+
+func errorFrame(requestID, code, message string) *pb.ServerFrame {
+	return sessionFrame(&pb.SessionResponse{
+		RequestId: requestID,
+		Response:  &pb.SessionResponse_Error{Error: &pb.SessionError{Code: code, Message: message}},
+	})
+}
+
+// clientRequest 客户端可以通过连接发送的请求，加入和确认由网关发送
+func clientRequest(req *pb.SessionRequest) bool {
+	switch req.GetRequest().(type) {
+	case *pb.SessionRequest_NightAction, *pb.SessionRequest_Vote, *pb.SessionRequest_Chat,
+		*pb.SessionRequest_Ready, *pb.SessionRequest_EndSpeech:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package websocket
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "liam/pkg/werewolf/v1"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCodecNegotiation(t *testing.T) {
+	hub := NewHub(nil, nil, nil, nil)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := hub.upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conn.Close()
+	}))
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	tests := []struct {
+		name      string
+		offered   []string
+		protocol  string
+		wantCodec codec
+	}{
+		{"未协商", nil, "", jsonCodec{}},
+		{"protojson", []string{ProtocolJSON}, ProtocolJSON, protojsonCodec{}},
+		{"优先二进制", []string{ProtocolJSON, ProtocolProtobuf}, ProtocolProtobuf, protobufCodec{}},
+		{"只提供 bearer", []string{bearerProtocol, "token"}, bearerProtocol, jsonCodec{}},
+		{"编码子协议与 bearer", []string{ProtocolJSON, bearerProtocol, "token"}, ProtocolJSON, protojsonCodec{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dialer := websocket.Dialer{Subprotocols: tt.offered}
+			conn, _, err := dialer.Dial(url, nil)
+			assert.NoError(t, err)
+			defer conn.Close()
+			assert.Equal(t, tt.protocol, conn.Subprotocol())
+			assert.Equal(t, tt.wantCodec, codecFor(conn.Subprotocol()))
+		})
+	}
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	request := &pb.ClientFrame{Frame: &pb.ClientFrame_Request{Request: &pb.SessionRequest{
+		RequestId: "r1",
+		Request:   &pb.SessionRequest_Vote{Vote: &pb.VoteRequest{TargetId: "p2"}},
+	}}}
+	event := eventFrame(&pb.GameEvent{
+		Seq:       3,
+		EventType: pb.GameEvent_EVENT_PHASE_CHANGED,
+		Message:   "天亮了",
+	})
+
+	tests := []struct {
+		name        string
+		codec       codec
+		messageType int
+		marshal     func(proto.Message) ([]byte, error)
+		unmarshal   func([]byte, proto.Message) error
+	}{
+		{"protojson", protojsonCodec{}, websocket.TextMessage, protojson.Marshal, protojson.Unmarshal},
+		{"protobuf", protobufCodec{}, websocket.BinaryMessage, proto.Marshal, proto.Unmarshal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.marshal(request)
+			assert.NoError(t, err)
+			decoded, fe := tt.codec.decode(tt.messageType, data)
+			assert.Nil(t, fe)
+			assert.True(t, proto.Equal(request, decoded))
+
+			// 帧类型与子协议不符时拒绝
+			other := websocket.BinaryMessage
+			if tt.messageType == websocket.BinaryMessage {
+				other = websocket.TextMessage
+			}
+			_, fe = tt.codec.decode(other, data)
+			assert.Equal(t, ErrCodeInvalidMessage, fe.code)
+
+			out := tt.codec.encode(event)
+			assert.Equal(t, tt.messageType == websocket.BinaryMessage, out.binary)
+			var frame pb.ServerFrame
+			assert.NoError(t, tt.unmarshal(out.data, &frame))
+			assert.True(t, proto.Equal(event, &frame))
+		})
+	}
+}
+
+func TestJSONCodec(t *testing.T) {
+	c := jsonCodec{}
+
+	frame, fe := c.decode(websocket.TextMessage, []byte(`{"type":"vote","request_id":"r1","payload":{"target_id":"p2"}}`))
+	assert.Nil(t, fe)
+	assert.Equal(t, "r1", frame.GetRequest().GetRequestId())
+	assert.Equal(t, "p2", frame.GetRequest().GetVote().GetTargetId())
+
+	frame, fe = c.decode(websocket.TextMessage, []byte(`{"type":"ping","request_id":"r2"}`))
+	assert.Nil(t, fe)
+	assert.Equal(t, "r2", frame.GetPing().GetRequestId())
+
+	_, fe = c.decode(websocket.TextMessage, []byte(`{"type":"teleport","request_id":"r3"}`))
+	assert.Equal(t, ErrCodeUnknownType, fe.code)
+	assert.Equal(t, "r3", fe.requestID)
+
+	_, fe = c.decode(websocket.TextMessage, []byte(`not json`))
+	assert.Equal(t, ErrCodeInvalidMessage, fe.code)
+
+	out := c.encode(&pb.ServerFrame{Frame: &pb.ServerFrame_Pong{Pong: &pb.GatewayPong{RequestId: "r2", Timestamp: 42}}})
+	assert.False(t, out.binary)
+	var msg ServerMessage
+	assert.NoError(t, json.Unmarshal(out.data, &msg))
+	assert.Equal(t, TypePong, msg.Type)
+	assert.Equal(t, "r2", msg.RequestID)
+
+	out = c.encode(eventFrame(&pb.GameEvent{Seq: 3, EventType: pb.GameEvent_EVENT_PHASE_CHANGED}))
+	assert.NoError(t, json.Unmarshal(out.data, &msg))
+	assert.Equal(t, TypeGameEvent, msg.Type)
+}
@@ -0,0 +1,168 @@
+package websocket
+
+import (
+	"encoding/json"
+	"errors"
+	"liam/internal/services"
+	pb "liam/pkg/werewolf/v1"
+)
+
+// 客户端消息类型
+const (
+	TypePing        = "ping"
+	TypeNightAction = "night_action"
+	TypeVote        = "vote"
+	TypeChat        = "chat"
+	TypeReady       = "ready"
+	TypeEndSpeech   = "end_speech"
+)
+
+// 服务端消息类型
+const (
+	TypePong        = "pong"
+	TypeAck         = "ack"   // 请求成功，payload 为游戏服务返回的结果
+	TypeError       = "error" // 请求失败或连接出错
+	TypeGameEvent   = "game_event"
+	TypeQueueUpdate = "queue_update"
+)
+
+// 错误码，除以下几种外为游戏服务返回的 gRPC 状态码名称，如 NotFound
+const (
+	ErrCodeInvalidMessage = "invalid_message"
+	ErrCodeUnknownType    = "unknown_type"
+	ErrCodeRejected       = "rejected" // 请求未通过游戏规则校验
+	ErrCodeUnavailable    = "unavailable"
+)
+
+// ClientMessage 客户端消息，payload 按 type 解析为对应的结构；未协商编码子协议时使用，见 codec.go
+type ClientMessage struct {
+	Type      string          `json:"type"`
+	RequestID string          `json:"request_id,omitempty"` // 原样带回对应的 ack 或 error
+	Payload   json.RawMessage `json:"payload,omitempty"`
+}
+
+type NightActionPayload struct {
+	ActionType string `json:"action_type"`
+	TargetID   string `json:"target_id"`
+}
+
+type VotePayload struct {
+	TargetID string `json:"target_id"`
+	Abstain  bool   `json:"abstain"`
+}
+
+type ChatPayload struct {
+	Channel string `json:"channel"`
+	Content string `json:"content"`
+}
+
+type ReadyPayload struct {
+	Ready bool `json:"ready"`
+}
+
+// ServerMessage 服务端消息
+type ServerMessage struct {
+	Type      string      `json:"type"`
+	RequestID string      `json:"request_id,omitempty"`
+	Payload   interface{} `json:"payload,omitempty"`
+}
+
+type ErrorPayload struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+var errUnknownType = errors.New("unknown message type")
+
+func errorMessage(requestID, code, message string) ServerMessage {
+	return ServerMessage{
+		Type:      TypeError,
+		RequestID: requestID,
+		Payload:   ErrorPayload{Code: code, Message: message},
+	}
+}
+
+// sessionRequest 将客户端消息转换为会话请求，房间和玩家身份由会话确定
+func sessionRequest(msg ClientMessage) (*pb.SessionRequest, error) {
+	req := &pb.SessionRequest{RequestId: msg.RequestID}
+
+	switch msg.Type {
+	case TypeNightAction:
+		var p NightActionPayload
+		if err := decodePayload(msg, &p); err != nil {
+			return nil, err
+		}
+		req.Request = &pb.SessionRequest_NightAction{NightAction: &pb.NightActionRequest{
+			TargetPlayerId: p.TargetID,
+			Action:         pb.ParseActionType(p.ActionType),
+		}}
+
+	case TypeVote:
+		var p VotePayload
+		if err := decodePayload(msg, &p); err != nil {
+			return nil, err
+		}
+		req.Request = &pb.SessionRequest_Vote{Vote: &pb.VoteRequest{
+			TargetId: p.TargetID,
+			Abstain:  p.Abstain,
+		}}
+
+	case TypeChat:
+		var p ChatPayload
+		if err := decodePayload(msg, &p); err != nil {
+			return nil, err
+		}
+		// 未知频道由游戏服务拒绝
+		channel, _ := services.ParseChatChannel(p.Channel)
+		req.Request = &pb.SessionRequest_Chat{Chat: &pb.SendChatMessageRequest{
+			Channel: channel,
+			Content: p.Content,
+		}}
+
+	case TypeReady:
+		var p ReadyPayload
+		if err := decodePayload(msg, &p); err != nil {
+			return nil, err
+		}
+		req.Request = &pb.SessionRequest_Ready{Ready: &pb.SessionReady{Ready: p.Ready}}
+
+	case TypeEndSpeech:
+		req.Request = &pb.SessionRequest_EndSpeech{EndSpeech: &pb.SessionEndSpeech{}}
+
+	default:
+		return nil, errUnknownType
+	}
+	return req, nil
+}
+
+func decodePayload(msg ClientMessage, v interface{}) error {
+	if len(msg.Payload) == 0 {
+		return nil
+	}
+	return json.Unmarshal(msg.Payload, v)
+}
+
+// actionResult 会话响应中的结果都带有 success 和 message
+type actionResult interface {
+	GetSuccess() bool
+	GetMessage() string
+}
+
+// sessionResult 取出会话响应中的结果，事件和错误返回 nil
+func sessionResult(resp *pb.SessionResponse) actionResult {
+	switch r := resp.Response.(type) {
+	case *pb.SessionResponse_Join:
+		return r.Join
+	case *pb.SessionResponse_NightAction:
+		return r.NightAction
+	case *pb.SessionResponse_Vote:
+		return r.Vote
+	case *pb.SessionResponse_Chat:
+		return r.Chat
+	case *pb.SessionResponse_Ready:
+		return r.Ready
+	case *pb.SessionResponse_EndSpeech:
+		return r.EndSpeech
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package websocket
+
+import (
+	"context"
+	"liam/internal/services"
+	"log"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// presenceTimeout 单次读写在线状态的超时
+const presenceTimeout = 3 * time.Second
+
+// Run 接收其他网关实例转发的消息，直到 ctx 取消
+func (h *Hub) Run(ctx context.Context) {
+	for msg := range h.presence.Subscribe(ctx) {
+		h.dispatch(msg)
+	}
+}
+
+// dispatch 处理其他实例转发的消息：断开连接、发给玩家或房间广播
+func (h *Hub) dispatch(msg *services.RelayMessage) {
+	switch {
+	case msg.ConnID != "":
+		h.mu.RLock()
+		client := h.clients[msg.PlayerID]
+		h.mu.RUnlock()
+		if client != nil && client.connID == msg.ConnID {
+			client.closeWith(msg.CloseCode, msg.Reason)
+		}
+
+	case msg.PlayerID != "":
+		h.mu.RLock()
+		client := h.clients[msg.PlayerID]
+		h.mu.RUnlock()
+		if client != nil {
+			client.offer(outbound{data: msg.Data})
+		}
+
+	case msg.RoomID != "":
+		h.broadcastLocal(msg.RoomID, msg.Data)
+	}
+}
+
+// SendToPlayer 向玩家发送消息，玩家连接在其他实例上时经 Redis 转发，不在线时忽略
+func (h *Hub) SendToPlayer(ctx context.Context, playerID string, message []byte) error {
+	h.mu.RLock()
+	client := h.clients[playerID]
+	h.mu.RUnlock()
+	if client != nil {
+		client.offer(outbound{data: message})
+		return nil
+	}
+
+	presence, err := h.presence.Lookup(ctx, playerID)
+	if err != nil || presence == nil || presence.InstanceID == h.presence.InstanceID() {
+		return err
+	}
+	return h.presence.PublishToInstance(ctx, presence.InstanceID, &services.RelayMessage{
+		PlayerID: playerID,
+		Data:     message,
+	})
+}
+
+// BroadcastToRoom 向房间内所有实例上的连接广播消息，消息队列已满的连接会被断开
+func (h *Hub) BroadcastToRoom(ctx context.Context, roomID string, message []byte) error {
+	h.broadcastLocal(roomID, message)
+	return h.presence.PublishToRoom(ctx, roomID, message)
+}
+
+func (h *Hub) broadcastLocal(roomID string, message []byte) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if feed := h.rooms[roomID]; feed != nil {
+		for client := range feed.clients {
+			client.offer(outbound{data: message})
+		}
+	}
+}
+
+// Disconnect 断开玩家的连接，连接在其他实例上时通知该实例断开，玩家不在线时忽略
+func (h *Hub) Disconnect(ctx context.Context, playerID string) error {
+	h.mu.RLock()
+	client := h.clients[playerID]
+	h.mu.RUnlock()
+	if client != nil {
+		client.closeWith(websocket.CloseNormalClosure, "left room")
+		return nil
+	}
+
+	presence, err := h.presence.Lookup(ctx, playerID)
+	if err != nil || presence == nil || presence.InstanceID == h.presence.InstanceID() {
+		return err
+	}
+	return h.presence.PublishToInstance(ctx, presence.InstanceID, &services.RelayMessage{
+		PlayerID:  playerID,
+		ConnID:    presence.ConnID,
+		CloseCode: websocket.CloseNormalClosure,
+		Reason:    "left room",
+	})
+}
+
+// OnlineCount 房间内所有实例上在线的玩家数
+func (h *Hub) OnlineCount(ctx context.Context, roomID string) (int, error) {
+	return h.presence.OnlineCount(ctx, roomID)
+}
+
+// connect 在 Redis 中登记连接，玩家在其他实例上的旧连接会被断开
+func (h *Hub) connect(client *Client) {
+	ctx, cancel := context.WithTimeout(context.Background(), presenceTimeout)
+	defer cancel()
+
+	previous, err := h.presence.Connect(ctx, client.playerID, client.connID)
+	if err != nil {
+		log.Printf("Failed to register presence: %v", err)
+		return
+	}
+	if previous == nil || previous.InstanceID == h.presence.InstanceID() {
+		return
+	}
+	if err := h.presence.PublishToInstance(ctx, previous.InstanceID, &services.RelayMessage{
+		PlayerID:  client.playerID,
+		ConnID:    previous.ConnID,
+		CloseCode: websocket.ClosePolicyViolation,
+		Reason:    "replaced by a new connection",
+	}); err != nil {
+		log.Printf("Failed to replace connection on instance %s: %v", previous.InstanceID, err)
+	}
+}
+
+// touch 刷新连接的最后活跃时间和所在房间
+func (c *Client) touch() {
+	ctx, cancel := context.WithTimeout(context.Background(), presenceTimeout)
+	defer cancel()
+
+	if err := c.hub.presence.Touch(ctx, c.playerID, c.connID, c.currentRoom()); err != nil {
+		log.Printf("Failed to refresh presence: %v", err)
+	}
+}
+
+// disconnect 移除连接的在线状态
+func (c *Client) disconnect() {
+	ctx, cancel := context.WithTimeout(context.Background(), presenceTimeout)
+	defer cancel()
+
+	if err := c.hub.presence.Disconnect(ctx, c.playerID, c.connID, c.currentRoom()); err != nil {
+		log.Printf("Failed to remove presence: %v", err)
+	}
+}
+
+func (c *Client) currentRoom() string {
+	c.hub.mu.RLock()
+	defer c.hub.mu.RUnlock()
+	return c.roomID
+}
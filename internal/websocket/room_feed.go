@@ -0,0 +1,156 @@
+package websocket
+
+import (
+	"context"
+	wsclient "liam/internal/client"
+	"liam/pkg/identity"
+	pb "liam/pkg/werewolf/v1"
+	"log"
+)
+
+// roomFeed 房间在网关内的事件分发，房间内所有本地连接共用一条上游订阅
+// 最后一条连接离开时取消订阅
+type roomFeed struct {
+	clients map[*Client]struct{}
+	cancel  context.CancelFunc
+}
+
+// joinRoom 将连接加入房间的分发列表，房间没有订阅时建立上游订阅
+// 连接已关闭时忽略
+func (h *Hub) joinRoom(client *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	select {
+	case <-client.done:
+		return
+	default:
+	}
+
+	feed := h.rooms[client.roomID]
+	if feed == nil {
+		ctx, cancel := context.WithCancel(identity.NewContext(context.Background(), identity.GatewayID))
+		feed = &roomFeed{
+			clients: make(map[*Client]struct{}),
+			cancel:  cancel,
+		}
+		h.rooms[client.roomID] = feed
+		go h.runFeed(ctx, client.roomID, feed)
+	}
+	feed.clients[client] = struct{}{}
+}
+
+// leaveRoom 将连接移出分发列表
+// 调用方需持有 h.mu
+func (h *Hub) leaveRoom(client *Client) {
+	feed := h.rooms[client.roomID]
+	if feed == nil {
+		return
+	}
+	delete(feed.clients, client)
+	if len(feed.clients) == 0 {
+		feed.cancel()
+		delete(h.rooms, client.roomID)
+	}
+}
+
+// runFeed 接收上游订阅的事件并分发给房间内的本地连接
+// 上游中断时按退避重新订阅并补发中断期间的事件；无法恢复时断开房间内的所有连接，由客户端重连
+func (h *Hub) runFeed(ctx context.Context, roomID string, feed *roomFeed) {
+	var lastSeq int64
+	err := wsclient.Resubscribe(ctx, "房间 "+roomID+" 的事件订阅", func(ctx context.Context) error {
+		// 同一事件可能分多次投递，中断时不确定是否收全，从上一个事件之后补发，重复的由连接丢弃
+		from := lastSeq - 1
+		if from < 0 {
+			from = 0
+		}
+		stream, err := h.grpcClient.SubscribeRoom(ctx, roomID, from)
+		if err != nil {
+			return err
+		}
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			if seq := event.Event.GetSeq(); seq > lastSeq {
+				lastSeq = seq
+			}
+
+			h.mu.RLock()
+			for client := range feed.clients {
+				client.deliver(event)
+			}
+			h.mu.RUnlock()
+		}
+	})
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	log.Printf("Room %s feed closed: %v", roomID, err)
+	h.mu.Lock()
+	if h.rooms[roomID] == feed {
+		delete(h.rooms, roomID)
+	}
+	clients := make([]*Client, 0, len(feed.clients))
+	for client := range feed.clients {
+		clients = append(clients, client)
+	}
+	h.mu.Unlock()
+
+	for _, client := range clients {
+		client.streamClosed("room feed", err)
+	}
+}
+
+// deliver 分发房间订阅的投递，加入房间前先缓存，加入后才知道是否入座
+// 不能阻塞，调用方持有 h.mu 读锁
+func (c *Client) deliver(event *pb.RoomEvent) {
+	c.feedMu.Lock()
+	defer c.feedMu.Unlock()
+
+	if !c.joined {
+		c.pending = append(c.pending, event)
+		return
+	}
+	c.offerEvent(event)
+}
+
+// markJoined 加入房间后按是否入座分发缓存的投递
+func (c *Client) markJoined(seated bool) {
+	c.feedMu.Lock()
+	defer c.feedMu.Unlock()
+
+	c.joined = true
+	c.seated = seated
+	for _, event := range c.pending {
+		c.offerEvent(event)
+	}
+	c.pending = nil
+}
+
+// offerEvent 发送连接应收到的投递，重新订阅时补发的重复事件会被丢弃
+// 调用方需持有 c.feedMu
+func (c *Client) offerEvent(event *pb.RoomEvent) {
+	if !c.receives(event) || event.Event.Seq <= c.lastSeq {
+		return
+	}
+	c.lastSeq = event.Event.Seq
+	c.offer(c.outbound(eventFrame(event.Event), event.Event.Seq))
+}
+
+// receives 判断连接是否应收到该投递，上帝视角观战者通过自己的会话接收事件
+// 调用方需持有 c.feedMu
+func (c *Client) receives(event *pb.RoomEvent) bool {
+	if c.godView {
+		return false
+	}
+	for _, id := range event.PlayerIds {
+		if id == c.playerID {
+			return true
+		}
+	}
+	return event.Spectators && !c.seated
+}
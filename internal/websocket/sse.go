@@ -0,0 +1,164 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	wsclient "liam/internal/client"
+	dto "liam/internal/dto/werewolf"
+	"liam/internal/services"
+	"liam/pkg/identity"
+	pb "liam/pkg/werewolf/v1"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// sseHeartbeat 心跳注释的间隔，避免代理因连接空闲断开
+	sseHeartbeat = 15 * time.Second
+	// sseRetry 建议浏览器断线后的重连间隔
+	sseRetry = 3 * time.Second
+)
+
+// StreamEvents 以 Server-Sent Events 推送游戏事件，用于无法使用 WebSocket 的网络环境
+// 事件内容与 WebSocket 的 game_event 相同，id 为事件序号；重连时按 Last-Event-ID 补发之后的事件
+// 操作仍通过 REST 接口提交
+// @Summary 订阅游戏事件（SSE）
+// @Tags Werewolf
+// @Produce text/event-stream
+// @Param room_id query string true "房间ID"
+// @Param spectate query bool false "以观战者身份订阅"
+// @Param last_event_id query int false "最后收到的事件序号，Last-Event-ID 头优先"
+// @Router /api/v1/game/events [get]
+func (h *Hub) StreamEvents(c *gin.Context) {
+	roomID := c.Query("room_id")
+	if roomID == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Success: false,
+			Error:   "invalid_request",
+			Message: "room_id is required",
+		})
+		return
+	}
+
+	// EventSource 首次连接不能带请求头，由查询参数指定；浏览器重连时自动带上 Last-Event-ID
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	var lastSeq int64
+	if lastEventID != "" {
+		seq, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil || seq < 0 {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Success: false,
+				Error:   "invalid_request",
+				Message: "Last-Event-ID must be an event sequence number",
+			})
+			return
+		}
+		lastSeq = seq
+	}
+
+	playerID, _ := identity.FromContext(c.Request.Context())
+	spectate := c.Query("spectate") == "true"
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	// 第一次订阅失败时直接返回，游戏服务熔断期间不会挂起
+	stream, err := h.grpcClient.SubscribeGameEvents(ctx, roomID, playerID, spectate, lastSeq)
+	if err != nil {
+		c.JSON(services.ErrorResponse(err))
+		return
+	}
+
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no") // 关闭 nginx 等反向代理的缓冲
+	c.Status(http.StatusOK)
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", sseRetry.Milliseconds())
+	c.Writer.Flush()
+
+	// 事件由单独的协程接收，上游中断时从最后收到的序号重新订阅；写入都在当前协程完成
+	events := make(chan *pb.GameEvent)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- wsclient.Resubscribe(ctx, "游戏事件流", func(ctx context.Context) error {
+			if stream == nil {
+				var err error
+				if stream, err = h.grpcClient.SubscribeGameEvents(ctx, roomID, playerID, spectate, lastSeq); err != nil {
+					return err
+				}
+			}
+			defer func() { stream = nil }()
+
+			for {
+				event, err := stream.Recv()
+				if err != nil {
+					return err
+				}
+				select {
+				case events <- event:
+					lastSeq = event.Seq
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		})
+	}()
+
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case event := <-events:
+			if err := writeSSE(c.Writer, strconv.FormatInt(event.Seq, 10), TypeGameEvent, eventMessage(event).Payload); err != nil {
+				return
+			}
+
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+
+		case err := <-errs:
+			if ctx.Err() == nil {
+				log.Printf("Game event stream closed: %v", err)
+				st := status.Convert(err)
+				writeSSE(c.Writer, "", TypeError, ErrorPayload{Code: st.Code().String(), Message: st.Message()})
+			}
+			return
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// writeSSE 写出一条 SSE 消息，id 为空时不改变浏览器记录的 Last-Event-ID
+func writeSSE(w gin.ResponseWriter, id, event string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	w.Flush()
+	return nil
+}
@@ -2,221 +2,656 @@ package websocket
 
 import (
 	"context"
-	"encoding/json"
-	client "liam/internal/client"
+	"errors"
+	wsclient "liam/internal/client"
+	dto "liam/internal/dto/werewolf"
+	"liam/internal/services"
+	"liam/pkg/identity"
+	"liam/pkg/middleware"
+	pb "liam/pkg/werewolf/v1"
+	"liam/utils"
 	"log"
 	"net/http"
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-var upgrader = websocket.Upgrader{
-	CheckOrigin: func(r *http.Request) bool {
-		return true // 生产环境需要更严格的检查
-	},
-}
+// 应用自定义关闭码（4000-4999），与对应的 HTTP 状态码保持一致
+const (
+	CloseBadRequest   = 4400
+	CloseUnauthorized = 4401
+	CloseForbidden    = 4403
+)
+
+// bearerProtocol 通过 Sec-WebSocket-Protocol 携带 JWT 时使用的子协议，客户端发送 "bearer, <token>"
+const bearerProtocol = "bearer"
+
+const (
+	writeWait  = 10 * time.Second
+	pongWait   = 60 * time.Second
+	pingPeriod = 54 * time.Second
+
+	// compressThreshold 达到该大小的消息才压缩，小消息压缩的收益不抵开销
+	compressThreshold = 1024
+)
 
-type WSHandler struct {
-	grpcClient *client.WerewolfGRPCClient
-	clients    map[string]*WSClient
+// Hub 管理所有 WebSocket 连接，每个玩家同时只保留一条连接
+// 房间事件通过每个房间一条的上游订阅分发；玩家的请求通过与游戏服务的会话转发，每个请求都会收到 ack 或 error
+// 在线状态保存在 Redis 中，多个网关实例之间通过 Redis 发布订阅转发消息，见 Run
+type Hub struct {
+	grpcClient *wsclient.WerewolfGRPCClient
+	tickets    services.WSTicketService
+	presence   services.PresenceService
+	upgrader   websocket.Upgrader
+	clients    map[string]*Client   // player_id -> 连接
+	rooms      map[string]*roomFeed // room_id -> 房间内的连接
 	mu         sync.RWMutex
 }
 
-type WSClient struct {
+// Client 一条 WebSocket 连接
+// 普通观战者不占用与游戏服务的流，只通过房间订阅接收事件；玩家和上帝视角观战者各有一条会话
+type Client struct {
+	hub      *Hub
 	conn     *websocket.Conn
-	roomID   string
+	roomID   string // 由 hub.mu 保护，匹配成功后更新
 	playerID string
-	send     chan []byte
+	connID   string // 区分同一玩家在不同实例上的连接
+	codec    codec  // 由握手时协商的子协议决定
+	send     chan outbound
+
+	// ctx 携带玩家身份，Close 时取消
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// done 关闭后所有协程退出，Close 可重复调用
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// 房间订阅的投递状态，见 deliver
+	feedMu  sync.Mutex
+	joined  bool
+	seated  bool
+	pending []*pb.RoomEvent
+	lastSeq int64 // 已发送的最新事件序号
+
+	// 观战者连接，godView 为延迟上帝视角
+	spectate bool
+	godView  bool
+
+	// 匹配连接：先排队，匹配成功后接入分配的房间
+	board      string
+	playerName string
+	queue      pb.WerewolfService_JoinQueueClient
+
+	// 与游戏服务的双向流会话，普通观战者为 nil；Send 需要加锁
+	session   pb.WerewolfService_GameSessionClient
+	sessionMu sync.Mutex
 }
 
-type WSMessage struct {
-	Type    string                 `json:"type"`
-	Payload map[string]interface{} `json:"payload"`
+// outbound 待发送的消息，ack 为写出后需要向会话确认的事件序号
+type outbound struct {
+	data   []byte
+	binary bool
+	ack    int64
 }
 
-func NewWSHandler(grpcClient *client.WerewolfGRPCClient) *WSHandler {
-	return &WSHandler{
+func NewHub(grpcClient *wsclient.WerewolfGRPCClient, tickets services.WSTicketService, presence services.PresenceService, allowedOrigins []string) *Hub {
+	return &Hub{
 		grpcClient: grpcClient,
-		clients:    make(map[string]*WSClient),
+		tickets:    tickets,
+		presence:   presence,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: checkOrigin(allowedOrigins),
+			// 优先选择编码子协议，只提供 bearer 时选择 bearer
+			Subprotocols:      []string{ProtocolProtobuf, ProtocolJSON, bearerProtocol},
+			EnableCompression: true,
+		},
+		clients: make(map[string]*Client),
+		rooms:   make(map[string]*roomFeed),
 	}
 }
 
-// HandleWebSocket 处理 WebSocket 连接
-func (h *WSHandler) HandleWebSocket(c *gin.Context) {
-	roomID := c.Query("room_id")
-	playerID := c.Query("player_id")
+// checkOrigin 按白名单校验 Origin，不在白名单中的只允许同源
+// 没有 Origin 头的是非浏览器客户端，由凭证鉴权
+func checkOrigin(allowed []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		for _, o := range allowed {
+			if o == "*" || strings.EqualFold(o, origin) {
+				return true
+			}
+		}
+		u, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(u.Host, r.Host)
+	}
+}
+
+// IssueTicket 为当前登录用户签发 WebSocket 连接凭证
+func (h *Hub) IssueTicket(c *gin.Context) {
+	playerID, _ := identity.FromContext(c.Request.Context())
+	playerName, _ := c.Get("user_name")
 
-	if roomID == "" || playerID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "room_id and player_id required"})
+	resp, err := h.tickets.Issue(c.Request.Context(), services.WSTicket{
+		PlayerID:   playerID,
+		PlayerName: middleware.ClaimString(playerName),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Success: false,
+			Error:   "service_error",
+			Message: err.Error(),
+		})
 		return
 	}
 
-	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	c.JSON(http.StatusOK, resp)
+}
+
+// HandleWebSocket 处理 WebSocket 连接
+// 连接需携带 ticket 参数，或在 Sec-WebSocket-Protocol 中携带 JWT；玩家身份取自凭证
+// Sec-WebSocket-Protocol 中可同时提供编码子协议 werewolf.v1.json 或 werewolf.v1.proto
+// 指定 room_id 时直接接入房间；指定 board 时先排队匹配，匹配成功后接入分配的房间
+func (h *Hub) HandleWebSocket(c *gin.Context) {
+	// Origin 不在白名单时 upgrader 直接返回 403
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
 		log.Printf("WebSocket upgrade error: %v", err)
 		return
 	}
 
-	client := &WSClient{
-		conn:     conn,
-		roomID:   roomID,
-		playerID: playerID,
-		send:     make(chan []byte, 256),
+	// 握手完成后再鉴权，失败时浏览器才能拿到关闭码
+	user, err := h.authenticate(c)
+	if err != nil {
+		log.Printf("WebSocket authentication failed: %v", err)
+		closeWithCode(conn, CloseUnauthorized, "unauthorized")
+		return
+	}
+
+	roomID := c.Query("room_id")
+	board := c.Query("board")
+	if claimed := c.Query("player_id"); claimed != "" && claimed != user.PlayerID {
+		closeWithCode(conn, CloseForbidden, "player_id does not match the authenticated user")
+		return
+	}
+	if roomID == "" && board == "" {
+		closeWithCode(conn, CloseBadRequest, "room_id or board required")
+		return
+	}
+
+	playerID := user.PlayerID
+	playerName := user.PlayerName
+	if playerName == "" {
+		playerName = c.Query("player_name")
 	}
 
+	// 排队匹配的连接总是入座
+	spectate := c.Query("spectate") == "true" && roomID != ""
+	godView := spectate && c.Query("god_view") == "true"
+
+	ctx, cancel := context.WithCancel(identity.NewContext(context.Background(), playerID))
+	var session pb.WerewolfService_GameSessionClient
+	if !spectate || godView {
+		session, err = h.grpcClient.OpenSession(ctx)
+		if err != nil {
+			cancel()
+			log.Printf("Failed to open game session: %v", err)
+			closeWithCode(conn, websocket.CloseTryAgainLater, "game service unavailable")
+			return
+		}
+	}
+
+	var queue pb.WerewolfService_JoinQueueClient
+	if roomID == "" {
+		queue, err = h.grpcClient.JoinQueue(ctx, playerID, playerName, board)
+		if err != nil {
+			cancel()
+			log.Printf("Failed to join matchmaking queue: %v", err)
+			closeWithCode(conn, websocket.CloseTryAgainLater, "game service unavailable")
+			return
+		}
+	}
+
+	client := &Client{
+		hub:        h,
+		conn:       conn,
+		roomID:     roomID,
+		playerID:   playerID,
+		connID:     uuid.NewString(),
+		codec:      codecFor(conn.Subprotocol()),
+		send:       make(chan outbound, 256),
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+		session:    session,
+		spectate:   spectate,
+		godView:    godView,
+		board:      board,
+		playerName: playerName,
+		queue:      queue,
+	}
+	h.register(client)
+
+	// 启动读写协程
+	go client.readPump()
+	go client.writePump()
+	switch {
+	case queue != nil:
+		go client.runQueue()
+	case session == nil:
+		go client.runSpectator()
+	default:
+		go client.runSession()
+	}
+}
+
+// authenticate 从 ticket 参数或 Sec-WebSocket-Protocol 中的 JWT 取得登录身份
+func (h *Hub) authenticate(c *gin.Context) (*services.WSTicket, error) {
+	if ticket := c.Query("ticket"); ticket != "" {
+		return h.tickets.Redeem(c.Request.Context(), ticket)
+	}
+
+	token := bearerToken(websocket.Subprotocols(c.Request))
+	if token == "" {
+		return nil, errors.New("missing websocket credentials")
+	}
+	claims, err := utils.ParseToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	playerID := middleware.ClaimString(claims["user_id"])
+	if playerID == "" {
+		return nil, errors.New("user_id claim required")
+	}
+	return &services.WSTicket{
+		PlayerID:   playerID,
+		PlayerName: middleware.ClaimString(claims["user_name"]),
+	}, nil
+}
+
+// bearerToken 取出子协议列表中 bearer 之后的令牌
+func bearerToken(protocols []string) string {
+	for i, p := range protocols {
+		if p == bearerProtocol && i+1 < len(protocols) {
+			return protocols[i+1]
+		}
+	}
+	return ""
+}
+
+// closeWithCode 发送关闭帧后断开连接
+func closeWithCode(conn *websocket.Conn, code int, reason string) {
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+	conn.Close()
+}
+
+// register 登记连接，同一玩家在本实例或其他实例上的旧连接会被断开
+func (h *Hub) register(client *Client) {
 	h.mu.Lock()
-	h.clients[playerID] = client
+	old := h.clients[client.playerID]
+	h.clients[client.playerID] = client
 	h.mu.Unlock()
 
-	// 启动读写协程
-	go h.readPump(client)
-	go h.writePump(client)
-	go h.subscribeGameEvents(client)
+	if old != nil {
+		old.closeWith(websocket.ClosePolicyViolation, "replaced by a new connection")
+	}
+	h.connect(client)
 }
 
-func (h *WSHandler) readPump(client *WSClient) {
-	defer func() {
-		h.removeClient(client.playerID)
-		client.conn.Close()
-	}()
+// unregister 移除连接，玩家已建立新连接时保留新连接
+func (h *Hub) unregister(client *Client) {
+	h.mu.Lock()
+	if h.clients[client.playerID] == client {
+		delete(h.clients, client.playerID)
+	}
+	h.leaveRoom(client)
+	h.mu.Unlock()
+}
+
+// Close 断开连接并结束与游戏服务的流，可重复调用
+// 先关闭 done，保证之后的 joinRoom 不会再登记该连接
+func (c *Client) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.hub.unregister(c)
+		c.cancel()
+		c.conn.Close()
+
+		c.feedMu.Lock()
+		spectating := c.joined && c.session == nil
+		c.feedMu.Unlock()
+		if spectating {
+			go c.stopSpectating()
+		}
+		go c.disconnect()
+	})
+}
+
+// stopSpectating 通知游戏服务观战者已离开
+func (c *Client) stopSpectating() {
+	ctx, cancel := context.WithTimeout(identity.NewContext(context.Background(), c.playerID), writeWait)
+	defer cancel()
+
+	if _, err := c.hub.grpcClient.StopSpectating(ctx, c.roomID, c.playerID); err != nil {
+		log.Printf("Failed to stop spectating: %v", err)
+	}
+}
+
+// closeWith 发送关闭帧后断开连接
+func (c *Client) closeWith(code int, reason string) {
+	c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+	c.Close()
+}
+
+// write 将消息放入发送队列，队列满时等待，连接关闭后丢弃
+func (c *Client) write(frame *pb.ServerFrame, ack int64) {
+	select {
+	case c.send <- c.outbound(frame, ack):
+	case <-c.done:
+	}
+}
 
-	client.conn.SetReadDeadline(time.Now().Add(60 * time.Second))
-	client.conn.SetPongHandler(func(string) error {
-		client.conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+// outbound 按连接协商的编码方式编码消息
+func (c *Client) outbound(frame *pb.ServerFrame, ack int64) outbound {
+	out := c.codec.encode(frame)
+	out.ack = ack
+	return out
+}
+
+// offer 将消息放入发送队列，不会阻塞，队列已满的慢连接会被断开
+func (c *Client) offer(out outbound) {
+	select {
+	case c.send <- out:
+	default:
+		go c.closeWith(websocket.CloseTryAgainLater, "too slow")
+	}
+}
+
+func (c *Client) readPump() {
+	defer c.Close()
+
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		go c.touch()
 		return nil
 	})
 
 	for {
-		_, message, err := client.conn.ReadMessage()
+		messageType, message, err := c.conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				log.Printf("WebSocket error: %v", err)
 			}
-			break
+			return
 		}
 
 		// 处理客户端消息
-		h.handleClientMessage(client, message)
+		c.handleMessage(messageType, message)
 	}
 }
 
-func (h *WSHandler) writePump(client *WSClient) {
-	ticker := time.NewTicker(54 * time.Second)
+// writePump 是唯一写数据帧的协程，send 通道不会被关闭，连接关闭时通过 done 退出
+// 事件写出后才向会话确认，断线重连时从确认的位置补发
+func (c *Client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
 	defer func() {
 		ticker.Stop()
-		client.conn.Close()
+		c.Close()
 	}()
 
 	for {
 		select {
-		case message, ok := <-client.send:
-			client.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
-			if !ok {
-				client.conn.WriteMessage(websocket.CloseMessage, []byte{})
-				return
+		case out := <-c.send:
+			messageType := websocket.TextMessage
+			if out.binary {
+				messageType = websocket.BinaryMessage
 			}
-
-			if err := client.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+			// 协商了 permessage-deflate 时只压缩较大的消息，如接入房间时的状态
+			c.conn.EnableWriteCompression(len(out.data) >= compressThreshold)
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(messageType, out.data); err != nil {
 				return
 			}
+			if out.ack > 0 && c.session != nil {
+				c.sendSession(&pb.SessionRequest{
+					Request: &pb.SessionRequest_Ack{Ack: &pb.SessionAck{Seq: out.ack}},
+				})
+			}
 
 		case <-ticker.C:
-			client.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
-			if err := client.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
 				return
 			}
+
+		case <-c.done:
+			return
 		}
 	}
 }
 
-func (h *WSHandler) subscribeGameEvents(client *WSClient) {
-	ctx := context.Background()
-	stream, err := h.grpcClient.SubscribeGameEvents(ctx, client.roomID, client.playerID)
-	if err != nil {
-		log.Printf("Failed to subscribe to game events: %v", err)
+// runQueue 转发排队状态为 queue_update，匹配成功后接入分配的房间
+func (c *Client) runQueue() {
+	for {
+		update, err := c.queue.Recv()
+		if err != nil {
+			c.streamClosed("matchmaking queue", err)
+			return
+		}
+
+		c.write(&pb.ServerFrame{Frame: &pb.ServerFrame_QueueUpdate{QueueUpdate: update}}, 0)
+
+		if update.Status == pb.QueueUpdate_STATUS_MATCHED {
+			c.hub.mu.Lock()
+			c.roomID = update.RoomId
+			c.hub.mu.Unlock()
+			c.runSession()
+			return
+		}
+	}
+}
+
+// joinRequestID 接入房间的结果以该 request_id 返回
+const joinRequestID = "join"
+
+// runSession 通过会话接入房间并转发请求结果
+// 玩家的实时事件来自房间订阅，会话只推送补发的历史事件；上帝视角观战者的事件全部来自会话
+func (c *Client) runSession() {
+	c.hub.joinRoom(c)
+	c.touch()
+	if err := c.sendSession(&pb.SessionRequest{
+		RequestId: joinRequestID,
+		Request: &pb.SessionRequest_Join{Join: &pb.SessionJoin{
+			RoomId:     c.roomID,
+			PlayerId:   c.playerID,
+			Spectate:   c.spectate,
+			GodView:    c.godView,
+			SkipEvents: !c.godView,
+		}},
+	}); err != nil {
+		c.streamClosed("game session", err)
 		return
 	}
 
 	for {
-		event, err := stream.Recv()
+		resp, err := c.session.Recv()
 		if err != nil {
-			log.Printf("Error receiving event: %v", err)
+			c.streamClosed("game session", err)
 			return
 		}
 
-		// 将事件发送给 WebSocket 客户端
-		eventData := map[string]interface{}{
-			"type":       event.EventType,
-			"message":    event.Message,
-			"phase_info": event.PhaseInfo,
-			"extra_data": event.ExtraData,
-			"timestamp":  event.Timestamp,
+		if event := resp.GetEvent(); event != nil {
+			c.write(eventFrame(event), event.Seq)
+			continue
 		}
-		/*
-			state           protoimpl.MessageState `protogen:"open.v1"`
-			EventType       GameEvent_EventType    `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=werewolf.GameEvent_EventType" json:"event_type,omitempty"`
-			Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-			PhaseInfo       *PhaseInfo             `protobuf:"bytes,3,opt,name=phase_info,json=phaseInfo,proto3" json:"phase_info,omitempty"`
-			AffectedPlayers []*Player              `protobuf:"bytes,4,rep,name=affected_players,json=affectedPlayers,proto3" json:"affected_players,omitempty"`
-			Timestamp       int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-			ExtraData       map[string]string      `protobuf:"bytes,6,rep,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-			unknownFields   protoimpl.UnknownFields
-			sizeCache       protoimpl.SizeCache
-		*/
-		msg := WSMessage{
-			Type:    "game_event",
-			Payload: eventData,
+
+		c.write(sessionFrame(resp), 0)
+		if resp.RequestId == joinRequestID {
+			if !c.joinSucceeded(resp.GetJoin(), resp.GetError()) {
+				return
+			}
 		}
+	}
+}
 
-		data, _ := json.Marshal(msg)
-		client.send <- data
+// runSpectator 普通观战者通过一元调用登记观战，之后只接收房间订阅的事件
+func (c *Client) runSpectator() {
+	c.hub.joinRoom(c)
+	c.touch()
+	resp, err := c.hub.grpcClient.JoinRoom(c.ctx, c.roomID, c.playerID, c.playerName, true, false)
+	if err != nil {
+		c.write(errorFrame(joinRequestID, status.Code(err).String(), status.Convert(err).Message()), 0)
+		c.closeWith(CloseBadRequest, "join failed")
+		return
+	}
+
+	c.write(sessionFrame(&pb.SessionResponse{
+		RequestId: joinRequestID,
+		Response:  &pb.SessionResponse_Join{Join: resp},
+	}), 0)
+	c.joinSucceeded(resp, nil)
+}
+
+// joinSucceeded 接入房间成功后开始分发房间事件，失败时断开连接
+func (c *Client) joinSucceeded(join *pb.JoinRoomResponse, sessionErr *pb.SessionError) bool {
+	if sessionErr != nil || !join.GetSuccess() {
+		c.closeWith(CloseBadRequest, "join failed")
+		return false
 	}
+	c.markJoined(join.Player != nil)
+	return true
 }
 
-func (h *WSHandler) handleClientMessage(client *WSClient, message []byte) {
-	var msg WSMessage
-	if err := json.Unmarshal(message, &msg); err != nil {
-		log.Printf("Error unmarshaling message: %v", err)
+// streamClosed 与游戏服务的流中断时通知客户端并断开连接，连接已关闭时忽略
+func (c *Client) streamClosed(name string, err error) {
+	select {
+	case <-c.done:
 		return
+	default:
+	}
+
+	log.Printf("Error receiving from %s: %v", name, err)
+	st := status.Convert(err)
+	code := st.Code().String()
+	if st.Code() == codes.Unavailable {
+		code = ErrCodeUnavailable
+	}
+	c.write(errorFrame("", code, st.Message()), 0)
+	c.closeWith(websocket.CloseTryAgainLater, name+" closed")
+}
+
+// resultMessage 将请求结果转换为 ack，游戏服务拒绝的请求转换为 error
+func resultMessage(resp *pb.SessionResponse) ServerMessage {
+	if e := resp.GetError(); e != nil {
+		return errorMessage(resp.RequestId, e.Code, e.Message)
+	}
+	if result := sessionResult(resp); result != nil && !result.GetSuccess() {
+		return errorMessage(resp.RequestId, ErrCodeRejected, result.GetMessage())
+	}
+	return ServerMessage{
+		Type:      TypeAck,
+		RequestID: resp.RequestId,
+		Payload:   wsclient.SessionResult(resp),
 	}
+}
 
-	// 根据消息类型处理
-	switch msg.Type {
-	case "ping":
-		response := WSMessage{
-			Type:    "pong",
-			Payload: map[string]interface{}{"timestamp": time.Now().Unix()},
+// eventMessage 将游戏事件转换为 WebSocket 消息
+func eventMessage(event *pb.GameEvent) ServerMessage {
+	eventData := map[string]interface{}{
+		"type":       event.EventType,
+		"message":    event.Message,
+		"phase_info": event.PhaseInfo,
+		"extra_data": event.ExtraData,
+		"timestamp":  event.Timestamp,
+		"seq":        event.Seq,
+		"payload":    wsclient.EventPayload(event),
+	}
+	if len(event.AffectedPlayers) > 0 {
+		players := make([]dto.PlayerInfo, len(event.AffectedPlayers))
+		for i, p := range event.AffectedPlayers {
+			players[i] = services.ToPlayerInfo(p)
 		}
-		data, _ := json.Marshal(response)
-		client.send <- data
+		eventData["affected_players"] = players
+	}
+	if event.EventType == pb.GameEvent_EVENT_YOUR_TURN {
+		eventData["available_actions"] = services.ToAvailableActions(event.AvailableActions)
+		eventData["deadline"] = event.Deadline
+	}
+
+	return ServerMessage{
+		Type:    TypeGameEvent,
+		Payload: eventData,
 	}
 }
 
-func (h *WSHandler) removeClient(playerID string) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
-	if client, ok := h.clients[playerID]; ok {
-		close(client.send)
-		delete(h.clients, playerID)
+// handleMessage 处理客户端消息，除 ping 外都转发给游戏服务
+func (c *Client) handleMessage(messageType int, data []byte) {
+	frame, fe := c.codec.decode(messageType, data)
+	if fe != nil {
+		c.write(errorFrame(fe.requestID, fe.code, fe.message), 0)
+		return
+	}
+
+	if ping := frame.GetPing(); ping != nil {
+		c.write(&pb.ServerFrame{Frame: &pb.ServerFrame_Pong{Pong: &pb.GatewayPong{
+			RequestId: ping.RequestId,
+			Timestamp: time.Now().Unix(),
+		}}}, 0)
+		return
+	}
+
+	req := frame.GetRequest()
+	if !clientRequest(req) {
+		c.write(errorFrame(req.GetRequestId(), ErrCodeUnknownType, "unsupported request"), 0)
+		return
+	}
+
+	if c.session == nil {
+		c.handleSpectatorRequest(req)
+		return
+	}
+
+	// 结果由 runSession 按 request_id 返回
+	if err := c.sendSession(req); err != nil {
+		c.write(errorFrame(req.RequestId, ErrCodeUnavailable, "game session closed"), 0)
 	}
 }
 
-// BroadcastToRoom 向房间内所有客户端广播消息
-func (h *WSHandler) BroadcastToRoom(roomID string, message []byte) {
-	h.mu.RLock()
-	defer h.mu.RUnlock()
+// handleSpectatorRequest 普通观战者没有会话，只能通过一元调用在观战频道发言
+func (c *Client) handleSpectatorRequest(req *pb.SessionRequest) {
+	chat := req.GetChat()
+	if chat == nil {
+		c.write(errorFrame(req.RequestId, ErrCodeRejected, "观战者不能执行该操作"), 0)
+		return
+	}
 
-	for _, client := range h.clients {
-		if client.roomID == roomID {
-			select {
-			case client.send <- message:
-			default:
-				close(client.send)
-				delete(h.clients, client.playerID)
-			}
-		}
+	resp, err := c.hub.grpcClient.SendChatMessage(c.ctx, c.roomID, c.playerID, chat.Channel, chat.Content)
+	if err != nil {
+		st := status.Convert(err)
+		c.write(errorFrame(req.RequestId, st.Code().String(), st.Message()), 0)
+		return
 	}
+	c.write(sessionFrame(&pb.SessionResponse{
+		RequestId: req.RequestId,
+		Response:  &pb.SessionResponse_Chat{Chat: resp},
+	}), 0)
+}
+
+// sendSession 向会话发送请求，gRPC 流不支持并发 Send
+func (c *Client) sendSession(req *pb.SessionRequest) error {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+	return c.session.Send(req)
 }
@@ -0,0 +1,171 @@
+// Package identity 在网关和游戏服务之间传递经过签名的玩家身份
+//
+// 网关从 JWT 中取得用户ID，放入请求 context，客户端拦截器用共享密钥签名后写入 gRPC metadata；
+// 游戏服务的拦截器校验签名，把玩家ID放回 context，供业务代码与座位比对。
+package identity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadata 键
+const (
+	MetadataPlayerID  = "x-player-id"
+	MetadataTimestamp = "x-player-ts"
+	MetadataSignature = "x-player-sig"
+)
+
+// MaxClockSkew 签名的有效时间窗口，防止截获的签名被长期重放
+const MaxClockSkew = 5 * time.Minute
+
+// GatewayID 网关自身的身份，用于订阅整个房间等不代表某个玩家的调用
+const GatewayID = "gateway"
+
+type contextKey struct{}
+
+// NewContext 返回携带玩家身份的 context
+func NewContext(ctx context.Context, playerID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, playerID)
+}
+
+// FromContext 取出 context 中的玩家身份
+func FromContext(ctx context.Context) (string, bool) {
+	playerID, ok := ctx.Value(contextKey{}).(string)
+	return playerID, ok && playerID != ""
+}
+
+// Signer 使用共享密钥签名和校验玩家身份
+type Signer struct {
+	secret []byte
+}
+
+func NewSigner(secret string) *Signer {
+	return &Signer{secret: []byte(secret)}
+}
+
+func (s *Signer) sign(playerID string, ts int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "%s:%d", playerID, ts)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// outgoing 将 context 中的玩家身份签名后写入 metadata，没有身份时原样返回
+func (s *Signer) outgoing(ctx context.Context) context.Context {
+	playerID, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	ts := time.Now().Unix()
+	return metadata.AppendToOutgoingContext(ctx,
+		MetadataPlayerID, playerID,
+		MetadataTimestamp, strconv.FormatInt(ts, 10),
+		MetadataSignature, s.sign(playerID, ts),
+	)
+}
+
+// Verify 校验 metadata 中的签名，返回玩家ID
+func (s *Signer) Verify(md metadata.MD) (string, error) {
+	playerID, tsValue, sig := first(md, MetadataPlayerID), first(md, MetadataTimestamp), first(md, MetadataSignature)
+	if playerID == "" || tsValue == "" || sig == "" {
+		return "", status.Error(codes.Unauthenticated, "缺少玩家身份")
+	}
+
+	ts, err := strconv.ParseInt(tsValue, 10, 64)
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "玩家身份时间戳无效")
+	}
+	if skew := time.Since(time.Unix(ts, 0)); skew > MaxClockSkew || skew < -MaxClockSkew {
+		return "", status.Error(codes.Unauthenticated, "玩家身份已过期")
+	}
+
+	if !hmac.Equal([]byte(sig), []byte(s.sign(playerID, ts))) {
+		return "", status.Error(codes.Unauthenticated, "玩家身份签名无效")
+	}
+	return playerID, nil
+}
+
+// incoming 校验请求 metadata 并把玩家身份放入 context
+func (s *Signer) incoming(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	playerID, err := s.Verify(md)
+	if err != nil {
+		return nil, err
+	}
+	return NewContext(ctx, playerID), nil
+}
+
+// UnaryClientInterceptor 为一元调用附加签名身份
+func (s *Signer) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(s.outgoing(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor 为流式调用附加签名身份
+func (s *Signer) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(s.outgoing(ctx), desc, cc, method, opts...)
+	}
+}
+
+// UnaryServerInterceptor 要求一元调用携带有效的签名身份
+func (s *Signer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if exempt(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := s.incoming(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor 要求流式调用携带有效的签名身份
+func (s *Signer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if exempt(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, err := s.incoming(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// exempt 反射服务不需要玩家身份
+func exempt(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.reflection.")
+}
+
+// serverStream 替换流的 context
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"liam/pkg/identity"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PlayerIdentity 以 AuthRequired 设置的 user_id 作为玩家身份写入请求 context，
+// 转发给游戏服务时由 gRPC 客户端签名；需放在 AuthRequired 之后
+func PlayerIdentity() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, _ := c.Get("user_id")
+		playerID := ClaimString(value)
+		if playerID == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "user_id claim required",
+			})
+			return
+		}
+
+		c.Request = c.Request.WithContext(identity.NewContext(c.Request.Context(), playerID))
+		c.Next()
+	}
+}
+
+// ClaimString 将 claim 转换为字符串，JWT 中的数字会被解析为 float64
+func ClaimString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
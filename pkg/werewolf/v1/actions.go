@@ -0,0 +1,33 @@
+package werewolfv1
+
+import "strings"
+
+// actionTypeNames 旧版字符串行动类型（兼容期内仍然接受）
+var actionTypeNames = map[ActionType]string{
+	ActionType_ACTION_GUARD:   "guard",
+	ActionType_ACTION_KILL:    "kill",
+	ActionType_ACTION_SAVE:    "save",
+	ActionType_ACTION_POISON:  "poison",
+	ActionType_ACTION_CHECK:   "check",
+	ActionType_ACTION_SKIP:    "skip",
+	ActionType_ACTION_VOTE:    "vote",
+	ActionType_ACTION_ABSTAIN: "abstain",
+}
+
+// LegacyName 返回行动类型对应的旧版字符串，如 ACTION_KILL -> "kill"
+func (a ActionType) LegacyName() string {
+	return actionTypeNames[a]
+}
+
+// ParseActionType 解析字符串行动类型，同时支持旧版写法（"kill"）和枚举名（"ACTION_KILL"）
+func ParseActionType(s string) ActionType {
+	if v, ok := ActionType_value[strings.ToUpper(s)]; ok {
+		return ActionType(v)
+	}
+	for action, name := range actionTypeNames {
+		if strings.EqualFold(name, s) {
+			return action
+		}
+	}
+	return ActionType_ACTION_UNKNOWN
+}
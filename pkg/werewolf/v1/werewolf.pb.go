@@ -0,0 +1,6093 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: v1/werewolf.proto
+
+// werewolf.v1 合并了原 werewolf.proto 与 werewolf_2.proto。
+// 兼容期内：
+//   1. 服务端同时以旧服务名 werewolf.WerewolfService 注册，旧客户端无需修改；
+//   2. 所有字段编号保持不变，标记为 deprecated 的字段仍会被填充；
+//   3. 兼容期结束后（v2）将删除 deprecated 字段和旧服务名。
+
+package werewolfv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 游戏阶段（细分）
+type Phase int32
+
+const (
+	Phase_PHASE_WAITING        Phase = 0
+	Phase_PHASE_NIGHT_GUARD    Phase = 1 // 守卫行动
+	Phase_PHASE_NIGHT_WEREWOLF Phase = 2 // 狼人行动
+	Phase_PHASE_NIGHT_WITCH    Phase = 3 // 女巫行动
+	Phase_PHASE_NIGHT_SEER     Phase = 4 // 预言家行动
+	Phase_PHASE_DAY_DISCUSSION Phase = 5 // 白天讨论
+	Phase_PHASE_DAY_VOTING     Phase = 6 // 投票
+	Phase_PHASE_DAY_LAST_WORDS Phase = 7 // 遗言
+	Phase_PHASE_GAME_OVER      Phase = 8 // 游戏结束
+)
+
+// Enum value maps for Phase.
+var (
+	Phase_name = map[int32]string{
+		0: "PHASE_WAITING",
+		1: "PHASE_NIGHT_GUARD",
+		2: "PHASE_NIGHT_WEREWOLF",
+		3: "PHASE_NIGHT_WITCH",
+		4: "PHASE_NIGHT_SEER",
+		5: "PHASE_DAY_DISCUSSION",
+		6: "PHASE_DAY_VOTING",
+		7: "PHASE_DAY_LAST_WORDS",
+		8: "PHASE_GAME_OVER",
+	}
+	Phase_value = map[string]int32{
+		"PHASE_WAITING":        0,
+		"PHASE_NIGHT_GUARD":    1,
+		"PHASE_NIGHT_WEREWOLF": 2,
+		"PHASE_NIGHT_WITCH":    3,
+		"PHASE_NIGHT_SEER":     4,
+		"PHASE_DAY_DISCUSSION": 5,
+		"PHASE_DAY_VOTING":     6,
+		"PHASE_DAY_LAST_WORDS": 7,
+		"PHASE_GAME_OVER":      8,
+	}
+)
+
+func (x Phase) Enum() *Phase {
+	p := new(Phase)
+	*p = x
+	return p
+}
+
+func (x Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[0].Descriptor()
+}
+
+func (Phase) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[0]
+}
+
+func (x Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Phase.Descriptor instead.
+func (Phase) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{0}
+}
+
+// 游戏状态
+type GameState int32
+
+const (
+	GameState_WAITING  GameState = 0
+	GameState_NIGHT    GameState = 1
+	GameState_DAY      GameState = 2
+	GameState_FINISHED GameState = 3
+)
+
+// Enum value maps for GameState.
+var (
+	GameState_name = map[int32]string{
+		0: "WAITING",
+		1: "NIGHT",
+		2: "DAY",
+		3: "FINISHED",
+	}
+	GameState_value = map[string]int32{
+		"WAITING":  0,
+		"NIGHT":    1,
+		"DAY":      2,
+		"FINISHED": 3,
+	}
+)
+
+func (x GameState) Enum() *GameState {
+	p := new(GameState)
+	*p = x
+	return p
+}
+
+func (x GameState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameState) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[1].Descriptor()
+}
+
+func (GameState) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[1]
+}
+
+func (x GameState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameState.Descriptor instead.
+func (GameState) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{1}
+}
+
+// 玩家角色
+type Role int32
+
+const (
+	Role_UNKNOWN  Role = 0
+	Role_WEREWOLF Role = 1
+	Role_VILLAGER Role = 2
+	Role_SEER     Role = 3
+	Role_WITCH    Role = 4
+	Role_HUNTER   Role = 5
+	Role_GUARD    Role = 6
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "WEREWOLF",
+		2: "VILLAGER",
+		3: "SEER",
+		4: "WITCH",
+		5: "HUNTER",
+		6: "GUARD",
+	}
+	Role_value = map[string]int32{
+		"UNKNOWN":  0,
+		"WEREWOLF": 1,
+		"VILLAGER": 2,
+		"SEER":     3,
+		"WITCH":    4,
+		"HUNTER":   5,
+		"GUARD":    6,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[2].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[2]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{2}
+}
+
+// 阵营
+type Camp int32
+
+const (
+	Camp_CAMP_UNKNOWN  Camp = 0
+	Camp_CAMP_WEREWOLF Camp = 1 // 狼人阵营
+	Camp_CAMP_VILLAGER Camp = 2 // 好人阵营
+)
+
+// Enum value maps for Camp.
+var (
+	Camp_name = map[int32]string{
+		0: "CAMP_UNKNOWN",
+		1: "CAMP_WEREWOLF",
+		2: "CAMP_VILLAGER",
+	}
+	Camp_value = map[string]int32{
+		"CAMP_UNKNOWN":  0,
+		"CAMP_WEREWOLF": 1,
+		"CAMP_VILLAGER": 2,
+	}
+)
+
+func (x Camp) Enum() *Camp {
+	p := new(Camp)
+	*p = x
+	return p
+}
+
+func (x Camp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Camp) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[3].Descriptor()
+}
+
+func (Camp) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[3]
+}
+
+func (x Camp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Camp.Descriptor instead.
+func (Camp) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{3}
+}
+
+// 行动类型
+type ActionType int32
+
+const (
+	ActionType_ACTION_UNKNOWN ActionType = 0
+	ActionType_ACTION_GUARD   ActionType = 1 // 守卫守护
+	ActionType_ACTION_KILL    ActionType = 2 // 狼人击杀
+	ActionType_ACTION_SAVE    ActionType = 3 // 女巫解药
+	ActionType_ACTION_POISON  ActionType = 4 // 女巫毒药
+	ActionType_ACTION_CHECK   ActionType = 5 // 预言家查验
+	ActionType_ACTION_SKIP    ActionType = 6 // 放弃行动
+	ActionType_ACTION_VOTE    ActionType = 7 // 白天投票
+	ActionType_ACTION_ABSTAIN ActionType = 8 // 白天弃票
+)
+
+// Enum value maps for ActionType.
+var (
+	ActionType_name = map[int32]string{
+		0: "ACTION_UNKNOWN",
+		1: "ACTION_GUARD",
+		2: "ACTION_KILL",
+		3: "ACTION_SAVE",
+		4: "ACTION_POISON",
+		5: "ACTION_CHECK",
+		6: "ACTION_SKIP",
+		7: "ACTION_VOTE",
+		8: "ACTION_ABSTAIN",
+	}
+	ActionType_value = map[string]int32{
+		"ACTION_UNKNOWN": 0,
+		"ACTION_GUARD":   1,
+		"ACTION_KILL":    2,
+		"ACTION_SAVE":    3,
+		"ACTION_POISON":  4,
+		"ACTION_CHECK":   5,
+		"ACTION_SKIP":    6,
+		"ACTION_VOTE":    7,
+		"ACTION_ABSTAIN": 8,
+	}
+)
+
+func (x ActionType) Enum() *ActionType {
+	p := new(ActionType)
+	*p = x
+	return p
+}
+
+func (x ActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[4].Descriptor()
+}
+
+func (ActionType) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[4]
+}
+
+func (x ActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionType.Descriptor instead.
+func (ActionType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{4}
+}
+
+// 投票模式
+type VoteMode int32
+
+const (
+	VoteMode_VOTE_MODE_OPEN      VoteMode = 0 // 记名投票，公布每个人的投票对象
+	VoteMode_VOTE_MODE_ANONYMOUS VoteMode = 1 // 匿名投票，只公布票数
+)
+
+// Enum value maps for VoteMode.
+var (
+	VoteMode_name = map[int32]string{
+		0: "VOTE_MODE_OPEN",
+		1: "VOTE_MODE_ANONYMOUS",
+	}
+	VoteMode_value = map[string]int32{
+		"VOTE_MODE_OPEN":      0,
+		"VOTE_MODE_ANONYMOUS": 1,
+	}
+)
+
+func (x VoteMode) Enum() *VoteMode {
+	p := new(VoteMode)
+	*p = x
+	return p
+}
+
+func (x VoteMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VoteMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[5].Descriptor()
+}
+
+func (VoteMode) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[5]
+}
+
+func (x VoteMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VoteMode.Descriptor instead.
+func (VoteMode) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{5}
+}
+
+// 玩家的连接状态
+type PresenceStatus int32
+
+const (
+	PresenceStatus_PRESENCE_UNKNOWN      PresenceStatus = 0
+	PresenceStatus_PRESENCE_ONLINE       PresenceStatus = 1 // 有连接在接收事件
+	PresenceStatus_PRESENCE_RECONNECTING PresenceStatus = 2 // 连接已断开，等待重连
+	PresenceStatus_PRESENCE_OFFLINE      PresenceStatus = 3 // 加入后没有连接过，或断开后超时未重连
+)
+
+// Enum value maps for PresenceStatus.
+var (
+	PresenceStatus_name = map[int32]string{
+		0: "PRESENCE_UNKNOWN",
+		1: "PRESENCE_ONLINE",
+		2: "PRESENCE_RECONNECTING",
+		3: "PRESENCE_OFFLINE",
+	}
+	PresenceStatus_value = map[string]int32{
+		"PRESENCE_UNKNOWN":      0,
+		"PRESENCE_ONLINE":       1,
+		"PRESENCE_RECONNECTING": 2,
+		"PRESENCE_OFFLINE":      3,
+	}
+)
+
+func (x PresenceStatus) Enum() *PresenceStatus {
+	p := new(PresenceStatus)
+	*p = x
+	return p
+}
+
+func (x PresenceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PresenceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[6].Descriptor()
+}
+
+func (PresenceStatus) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[6]
+}
+
+func (x PresenceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PresenceStatus.Descriptor instead.
+func (PresenceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{6}
+}
+
+// 业务错误原因，随 success=false 的响应或 gRPC 错误详情返回，网关据此区分错误类型
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED          ErrorReason = 0
+	ErrorReason_ERROR_REASON_ROOM_NOT_FOUND       ErrorReason = 1
+	ErrorReason_ERROR_REASON_PLAYER_NOT_FOUND     ErrorReason = 2
+	ErrorReason_ERROR_REASON_ROOM_FULL            ErrorReason = 3
+	ErrorReason_ERROR_REASON_GAME_ALREADY_STARTED ErrorReason = 4
+	ErrorReason_ERROR_REASON_WRONG_PHASE          ErrorReason = 5 // 当前阶段不能执行该操作
+	ErrorReason_ERROR_REASON_NOT_YOUR_TURN        ErrorReason = 6
+	ErrorReason_ERROR_REASON_INVALID_TARGET       ErrorReason = 7
+	ErrorReason_ERROR_REASON_ALREADY_VOTED        ErrorReason = 8
+	ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED   ErrorReason = 9  // 身份或状态不允许，如死亡玩家投票、观战者准备
+	ErrorReason_ERROR_REASON_INVALID_MESSAGE      ErrorReason = 10 // 聊天消息为空或过长
+	ErrorReason_ERROR_REASON_RATE_LIMITED         ErrorReason = 11
+	ErrorReason_ERROR_REASON_INVALID_ROLE_CONFIG  ErrorReason = 12
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "ERROR_REASON_UNSPECIFIED",
+		1:  "ERROR_REASON_ROOM_NOT_FOUND",
+		2:  "ERROR_REASON_PLAYER_NOT_FOUND",
+		3:  "ERROR_REASON_ROOM_FULL",
+		4:  "ERROR_REASON_GAME_ALREADY_STARTED",
+		5:  "ERROR_REASON_WRONG_PHASE",
+		6:  "ERROR_REASON_NOT_YOUR_TURN",
+		7:  "ERROR_REASON_INVALID_TARGET",
+		8:  "ERROR_REASON_ALREADY_VOTED",
+		9:  "ERROR_REASON_ACTION_NOT_ALLOWED",
+		10: "ERROR_REASON_INVALID_MESSAGE",
+		11: "ERROR_REASON_RATE_LIMITED",
+		12: "ERROR_REASON_INVALID_ROLE_CONFIG",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":          0,
+		"ERROR_REASON_ROOM_NOT_FOUND":       1,
+		"ERROR_REASON_PLAYER_NOT_FOUND":     2,
+		"ERROR_REASON_ROOM_FULL":            3,
+		"ERROR_REASON_GAME_ALREADY_STARTED": 4,
+		"ERROR_REASON_WRONG_PHASE":          5,
+		"ERROR_REASON_NOT_YOUR_TURN":        6,
+		"ERROR_REASON_INVALID_TARGET":       7,
+		"ERROR_REASON_ALREADY_VOTED":        8,
+		"ERROR_REASON_ACTION_NOT_ALLOWED":   9,
+		"ERROR_REASON_INVALID_MESSAGE":      10,
+		"ERROR_REASON_RATE_LIMITED":         11,
+		"ERROR_REASON_INVALID_ROLE_CONFIG":  12,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[7].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[7]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{7}
+}
+
+// 聊天频道
+type ChatChannel int32
+
+const (
+	ChatChannel_CHAT_CHANNEL_UNKNOWN   ChatChannel = 0
+	ChatChannel_CHAT_CHANNEL_PUBLIC    ChatChannel = 1 // 公共频道：白天及游戏开始前/结束后
+	ChatChannel_CHAT_CHANNEL_WEREWOLF  ChatChannel = 2 // 狼人频道：夜晚狼人之间
+	ChatChannel_CHAT_CHANNEL_DEAD      ChatChannel = 3 // 死亡玩家频道
+	ChatChannel_CHAT_CHANNEL_SPECTATOR ChatChannel = 4 // 观战频道
+)
+
+// Enum value maps for ChatChannel.
+var (
+	ChatChannel_name = map[int32]string{
+		0: "CHAT_CHANNEL_UNKNOWN",
+		1: "CHAT_CHANNEL_PUBLIC",
+		2: "CHAT_CHANNEL_WEREWOLF",
+		3: "CHAT_CHANNEL_DEAD",
+		4: "CHAT_CHANNEL_SPECTATOR",
+	}
+	ChatChannel_value = map[string]int32{
+		"CHAT_CHANNEL_UNKNOWN":   0,
+		"CHAT_CHANNEL_PUBLIC":    1,
+		"CHAT_CHANNEL_WEREWOLF":  2,
+		"CHAT_CHANNEL_DEAD":      3,
+		"CHAT_CHANNEL_SPECTATOR": 4,
+	}
+)
+
+func (x ChatChannel) Enum() *ChatChannel {
+	p := new(ChatChannel)
+	*p = x
+	return p
+}
+
+func (x ChatChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[8].Descriptor()
+}
+
+func (ChatChannel) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[8]
+}
+
+func (x ChatChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatChannel.Descriptor instead.
+func (ChatChannel) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{8}
+}
+
+// 死亡原因
+type DeathCause int32
+
+const (
+	DeathCause_DEATH_CAUSE_NONE     DeathCause = 0 // 存活
+	DeathCause_DEATH_CAUSE_WEREWOLF DeathCause = 1 // 被狼人击杀
+	DeathCause_DEATH_CAUSE_POISON   DeathCause = 2 // 被女巫毒杀
+	DeathCause_DEATH_CAUSE_VOTE     DeathCause = 3 // 被投票放逐
+)
+
+// Enum value maps for DeathCause.
+var (
+	DeathCause_name = map[int32]string{
+		0: "DEATH_CAUSE_NONE",
+		1: "DEATH_CAUSE_WEREWOLF",
+		2: "DEATH_CAUSE_POISON",
+		3: "DEATH_CAUSE_VOTE",
+	}
+	DeathCause_value = map[string]int32{
+		"DEATH_CAUSE_NONE":     0,
+		"DEATH_CAUSE_WEREWOLF": 1,
+		"DEATH_CAUSE_POISON":   2,
+		"DEATH_CAUSE_VOTE":     3,
+	}
+)
+
+func (x DeathCause) Enum() *DeathCause {
+	p := new(DeathCause)
+	*p = x
+	return p
+}
+
+func (x DeathCause) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeathCause) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[9].Descriptor()
+}
+
+func (DeathCause) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[9]
+}
+
+func (x DeathCause) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeathCause.Descriptor instead.
+func (DeathCause) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{9}
+}
+
+type GameEvent_EventType int32
+
+const (
+	GameEvent_EVENT_UNKNOWN          GameEvent_EventType = 0
+	GameEvent_EVENT_PLAYER_JOINED    GameEvent_EventType = 1
+	GameEvent_EVENT_GAME_STARTED     GameEvent_EventType = 2
+	GameEvent_EVENT_PHASE_CHANGED    GameEvent_EventType = 3
+	GameEvent_EVENT_PLAYER_DIED      GameEvent_EventType = 4
+	GameEvent_EVENT_ACTION_COMPLETED GameEvent_EventType = 5
+	GameEvent_EVENT_GAME_OVER        GameEvent_EventType = 6
+	GameEvent_EVENT_YOUR_TURN        GameEvent_EventType = 7  // 轮到你行动
+	GameEvent_EVENT_VOTE_RESULT      GameEvent_EventType = 8  // 投票结果
+	GameEvent_EVENT_NIGHT_RESULT     GameEvent_EventType = 9  // 夜晚行动结果（仅行动者可见）
+	GameEvent_EVENT_PLAYER_AFK       GameEvent_EventType = 10 // 玩家挂机
+	GameEvent_EVENT_CHAT             GameEvent_EventType = 11 // 聊天消息（按频道过滤）
+	GameEvent_EVENT_SPEAKER_CHANGED  GameEvent_EventType = 12 // 轮到下一位发言
+	GameEvent_EVENT_PLAYER_READY     GameEvent_EventType = 13 // 玩家切换准备状态
+	GameEvent_EVENT_PLAYER_PRESENCE  GameEvent_EventType = 14 // 玩家上线、断线或离线
+)
+
+// Enum value maps for GameEvent_EventType.
+var (
+	GameEvent_EventType_name = map[int32]string{
+		0:  "EVENT_UNKNOWN",
+		1:  "EVENT_PLAYER_JOINED",
+		2:  "EVENT_GAME_STARTED",
+		3:  "EVENT_PHASE_CHANGED",
+		4:  "EVENT_PLAYER_DIED",
+		5:  "EVENT_ACTION_COMPLETED",
+		6:  "EVENT_GAME_OVER",
+		7:  "EVENT_YOUR_TURN",
+		8:  "EVENT_VOTE_RESULT",
+		9:  "EVENT_NIGHT_RESULT",
+		10: "EVENT_PLAYER_AFK",
+		11: "EVENT_CHAT",
+		12: "EVENT_SPEAKER_CHANGED",
+		13: "EVENT_PLAYER_READY",
+		14: "EVENT_PLAYER_PRESENCE",
+	}
+	GameEvent_EventType_value = map[string]int32{
+		"EVENT_UNKNOWN":          0,
+		"EVENT_PLAYER_JOINED":    1,
+		"EVENT_GAME_STARTED":     2,
+		"EVENT_PHASE_CHANGED":    3,
+		"EVENT_PLAYER_DIED":      4,
+		"EVENT_ACTION_COMPLETED": 5,
+		"EVENT_GAME_OVER":        6,
+		"EVENT_YOUR_TURN":        7,
+		"EVENT_VOTE_RESULT":      8,
+		"EVENT_NIGHT_RESULT":     9,
+		"EVENT_PLAYER_AFK":       10,
+		"EVENT_CHAT":             11,
+		"EVENT_SPEAKER_CHANGED":  12,
+		"EVENT_PLAYER_READY":     13,
+		"EVENT_PLAYER_PRESENCE":  14,
+	}
+)
+
+func (x GameEvent_EventType) Enum() *GameEvent_EventType {
+	p := new(GameEvent_EventType)
+	*p = x
+	return p
+}
+
+func (x GameEvent_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[10].Descriptor()
+}
+
+func (GameEvent_EventType) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[10]
+}
+
+func (x GameEvent_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameEvent_EventType.Descriptor instead.
+func (GameEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{35, 0}
+}
+
+type QueueUpdate_Status int32
+
+const (
+	QueueUpdate_STATUS_UNKNOWN QueueUpdate_Status = 0
+	QueueUpdate_STATUS_WAITING QueueUpdate_Status = 1 // 排队中
+	QueueUpdate_STATUS_MATCHED QueueUpdate_Status = 2 // 匹配成功，room_id 为已开始的房间
+)
+
+// Enum value maps for QueueUpdate_Status.
+var (
+	QueueUpdate_Status_name = map[int32]string{
+		0: "STATUS_UNKNOWN",
+		1: "STATUS_WAITING",
+		2: "STATUS_MATCHED",
+	}
+	QueueUpdate_Status_value = map[string]int32{
+		"STATUS_UNKNOWN": 0,
+		"STATUS_WAITING": 1,
+		"STATUS_MATCHED": 2,
+	}
+)
+
+func (x QueueUpdate_Status) Enum() *QueueUpdate_Status {
+	p := new(QueueUpdate_Status)
+	*p = x
+	return p
+}
+
+func (x QueueUpdate_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueUpdate_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_werewolf_proto_enumTypes[11].Descriptor()
+}
+
+func (QueueUpdate_Status) Type() protoreflect.EnumType {
+	return &file_v1_werewolf_proto_enumTypes[11]
+}
+
+func (x QueueUpdate_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueUpdate_Status.Descriptor instead.
+func (QueueUpdate_Status) EnumDescriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{52, 0}
+}
+
+// 附加在 gRPC 错误中的详情，通过 status.WithDetails 传递
+type ErrorDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        ErrorReason            `protobuf:"varint,1,opt,name=reason,proto3,enum=werewolf.v1.ErrorReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorDetail) Reset() {
+	*x = ErrorDetail{}
+	mi := &file_v1_werewolf_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetail) ProtoMessage() {}
+
+func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ErrorDetail) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+// 玩家信息
+type Player struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role          Role                   `protobuf:"varint,3,opt,name=role,proto3,enum=werewolf.v1.Role" json:"role,omitempty"`
+	Camp          Camp                   `protobuf:"varint,4,opt,name=camp,proto3,enum=werewolf.v1.Camp" json:"camp,omitempty"`
+	IsAlive       bool                   `protobuf:"varint,5,opt,name=is_alive,json=isAlive,proto3" json:"is_alive,omitempty"`
+	Position      int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	CanAct        bool                   `protobuf:"varint,7,opt,name=can_act,json=canAct,proto3" json:"can_act,omitempty"`                      // 当前是否可以行动
+	IsAfk         bool                   `protobuf:"varint,8,opt,name=is_afk,json=isAfk,proto3" json:"is_afk,omitempty"`                         // 连续超时被标记为挂机
+	BotControlled bool                   `protobuf:"varint,9,opt,name=bot_controlled,json=botControlled,proto3" json:"bot_controlled,omitempty"` // 已由机器人托管
+	Ready         bool                   `protobuf:"varint,10,opt,name=ready,proto3" json:"ready,omitempty"`                                     // 开局前已准备
+	Presence      PresenceStatus         `protobuf:"varint,11,opt,name=presence,proto3,enum=werewolf.v1.PresenceStatus" json:"presence,omitempty"`
+	LastSeen      int64                  `protobuf:"varint,12,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // 最近一次上线或断线的时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	mi := &file_v1_werewolf_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Player) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Player) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN
+}
+
+func (x *Player) GetCamp() Camp {
+	if x != nil {
+		return x.Camp
+	}
+	return Camp_CAMP_UNKNOWN
+}
+
+func (x *Player) GetIsAlive() bool {
+	if x != nil {
+		return x.IsAlive
+	}
+	return false
+}
+
+func (x *Player) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Player) GetCanAct() bool {
+	if x != nil {
+		return x.CanAct
+	}
+	return false
+}
+
+func (x *Player) GetIsAfk() bool {
+	if x != nil {
+		return x.IsAfk
+	}
+	return false
+}
+
+func (x *Player) GetBotControlled() bool {
+	if x != nil {
+		return x.BotControlled
+	}
+	return false
+}
+
+func (x *Player) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Player) GetPresence() PresenceStatus {
+	if x != nil {
+		return x.Presence
+	}
+	return PresenceStatus_PRESENCE_UNKNOWN
+}
+
+func (x *Player) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+// 夜晚行动记录
+type NightAction struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Role     Role                   `protobuf:"varint,2,opt,name=role,proto3,enum=werewolf.v1.Role" json:"role,omitempty"`
+	TargetId string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Deprecated: Marked as deprecated in v1/werewolf.proto.
+	ActionType    string     `protobuf:"bytes,4,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"` // 使用 action
+	Timestamp     int64      `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Action        ActionType `protobuf:"varint,6,opt,name=action,proto3,enum=werewolf.v1.ActionType" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NightAction) Reset() {
+	*x = NightAction{}
+	mi := &file_v1_werewolf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NightAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NightAction) ProtoMessage() {}
+
+func (x *NightAction) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NightAction.ProtoReflect.Descriptor instead.
+func (*NightAction) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NightAction) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *NightAction) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN
+}
+
+func (x *NightAction) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in v1/werewolf.proto.
+func (x *NightAction) GetActionType() string {
+	if x != nil {
+		return x.ActionType
+	}
+	return ""
+}
+
+func (x *NightAction) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *NightAction) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ACTION_UNKNOWN
+}
+
+// 阶段信息
+type PhaseInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPhase  Phase                  `protobuf:"varint,1,opt,name=current_phase,json=currentPhase,proto3,enum=werewolf.v1.Phase" json:"current_phase,omitempty"`
+	PhaseName     string                 `protobuf:"bytes,2,opt,name=phase_name,json=phaseName,proto3" json:"phase_name,omitempty"`
+	ActiveRoles   []string               `protobuf:"bytes,3,rep,name=active_roles,json=activeRoles,proto3" json:"active_roles,omitempty"` // 当前阶段活跃的角色
+	TimeLimit     int32                  `protobuf:"varint,4,opt,name=time_limit,json=timeLimit,proto3" json:"time_limit,omitempty"`      // 阶段时间限制（秒）
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PhaseInfo) Reset() {
+	*x = PhaseInfo{}
+	mi := &file_v1_werewolf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhaseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhaseInfo) ProtoMessage() {}
+
+func (x *PhaseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhaseInfo.ProtoReflect.Descriptor instead.
+func (*PhaseInfo) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PhaseInfo) GetCurrentPhase() Phase {
+	if x != nil {
+		return x.CurrentPhase
+	}
+	return Phase_PHASE_WAITING
+}
+
+func (x *PhaseInfo) GetPhaseName() string {
+	if x != nil {
+		return x.PhaseName
+	}
+	return ""
+}
+
+func (x *PhaseInfo) GetActiveRoles() []string {
+	if x != nil {
+		return x.ActiveRoles
+	}
+	return nil
+}
+
+func (x *PhaseInfo) GetTimeLimit() int32 {
+	if x != nil {
+		return x.TimeLimit
+	}
+	return 0
+}
+
+func (x *PhaseInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// 玩家当前可执行的行动
+type AvailableAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in v1/werewolf.proto.
+	ActionType     string     `protobuf:"bytes,1,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"` // 使用 action
+	TargetIds      []string   `protobuf:"bytes,2,rep,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"`    // 合法目标
+	RequiresTarget bool       `protobuf:"varint,3,opt,name=requires_target,json=requiresTarget,proto3" json:"requires_target,omitempty"`
+	RemainingUses  int32      `protobuf:"varint,4,opt,name=remaining_uses,json=remainingUses,proto3" json:"remaining_uses,omitempty"` // 剩余次数（女巫药水），-1 表示不限
+	Description    string     `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Action         ActionType `protobuf:"varint,6,opt,name=action,proto3,enum=werewolf.v1.ActionType" json:"action,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AvailableAction) Reset() {
+	*x = AvailableAction{}
+	mi := &file_v1_werewolf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailableAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableAction) ProtoMessage() {}
+
+func (x *AvailableAction) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableAction.ProtoReflect.Descriptor instead.
+func (*AvailableAction) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{4}
+}
+
+// Deprecated: Marked as deprecated in v1/werewolf.proto.
+func (x *AvailableAction) GetActionType() string {
+	if x != nil {
+		return x.ActionType
+	}
+	return ""
+}
+
+func (x *AvailableAction) GetTargetIds() []string {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+func (x *AvailableAction) GetRequiresTarget() bool {
+	if x != nil {
+		return x.RequiresTarget
+	}
+	return false
+}
+
+func (x *AvailableAction) GetRemainingUses() int32 {
+	if x != nil {
+		return x.RemainingUses
+	}
+	return 0
+}
+
+func (x *AvailableAction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AvailableAction) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ACTION_UNKNOWN
+}
+
+// 女巫行动提示
+type WitchPrompt struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	VictimId        string                 `protobuf:"bytes,1,opt,name=victim_id,json=victimId,proto3" json:"victim_id,omitempty"` // 今晚死亡的玩家，平安夜为空
+	SaveAvailable   bool                   `protobuf:"varint,2,opt,name=save_available,json=saveAvailable,proto3" json:"save_available,omitempty"`
+	PoisonAvailable bool                   `protobuf:"varint,3,opt,name=poison_available,json=poisonAvailable,proto3" json:"poison_available,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WitchPrompt) Reset() {
+	*x = WitchPrompt{}
+	mi := &file_v1_werewolf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WitchPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WitchPrompt) ProtoMessage() {}
+
+func (x *WitchPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WitchPrompt.ProtoReflect.Descriptor instead.
+func (*WitchPrompt) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WitchPrompt) GetVictimId() string {
+	if x != nil {
+		return x.VictimId
+	}
+	return ""
+}
+
+func (x *WitchPrompt) GetSaveAvailable() bool {
+	if x != nil {
+		return x.SaveAvailable
+	}
+	return false
+}
+
+func (x *WitchPrompt) GetPoisonAvailable() bool {
+	if x != nil {
+		return x.PoisonAvailable
+	}
+	return false
+}
+
+// 预言家查验结果
+type SeerResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Camp          Camp                   `protobuf:"varint,2,opt,name=camp,proto3,enum=werewolf.v1.Camp" json:"camp,omitempty"`
+	Day           int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeerResult) Reset() {
+	*x = SeerResult{}
+	mi := &file_v1_werewolf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeerResult) ProtoMessage() {}
+
+func (x *SeerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeerResult.ProtoReflect.Descriptor instead.
+func (*SeerResult) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SeerResult) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SeerResult) GetCamp() Camp {
+	if x != nil {
+		return x.Camp
+	}
+	return Camp_CAMP_UNKNOWN
+}
+
+func (x *SeerResult) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+// 女巫用药记录
+type WitchPotionRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Action        ActionType             `protobuf:"varint,2,opt,name=action,proto3,enum=werewolf.v1.ActionType" json:"action,omitempty"` // ACTION_SAVE 或 ACTION_POISON
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WitchPotionRecord) Reset() {
+	*x = WitchPotionRecord{}
+	mi := &file_v1_werewolf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WitchPotionRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WitchPotionRecord) ProtoMessage() {}
+
+func (x *WitchPotionRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WitchPotionRecord.ProtoReflect.Descriptor instead.
+func (*WitchPotionRecord) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WitchPotionRecord) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *WitchPotionRecord) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ACTION_UNKNOWN
+}
+
+func (x *WitchPotionRecord) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+// 守卫守护记录
+type GuardRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuardRecord) Reset() {
+	*x = GuardRecord{}
+	mi := &file_v1_werewolf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuardRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardRecord) ProtoMessage() {}
+
+func (x *GuardRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardRecord.ProtoReflect.Descriptor instead.
+func (*GuardRecord) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GuardRecord) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *GuardRecord) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+// 玩家私有信息，仅本人可见，断线重连后可通过 GetGameState 取回
+type PrivateKnowledge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeerChecks    []*SeerResult          `protobuf:"bytes,1,rep,name=seer_checks,json=seerChecks,proto3" json:"seer_checks,omitempty"`
+	WitchPotions  []*WitchPotionRecord   `protobuf:"bytes,2,rep,name=witch_potions,json=witchPotions,proto3" json:"witch_potions,omitempty"`
+	GuardRecords  []*GuardRecord         `protobuf:"bytes,3,rep,name=guard_records,json=guardRecords,proto3" json:"guard_records,omitempty"`
+	LoverId       string                 `protobuf:"bytes,4,opt,name=lover_id,json=loverId,proto3" json:"lover_id,omitempty"` // 情侣，开局结为情侣的玩家才有
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivateKnowledge) Reset() {
+	*x = PrivateKnowledge{}
+	mi := &file_v1_werewolf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivateKnowledge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateKnowledge) ProtoMessage() {}
+
+func (x *PrivateKnowledge) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateKnowledge.ProtoReflect.Descriptor instead.
+func (*PrivateKnowledge) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PrivateKnowledge) GetSeerChecks() []*SeerResult {
+	if x != nil {
+		return x.SeerChecks
+	}
+	return nil
+}
+
+func (x *PrivateKnowledge) GetWitchPotions() []*WitchPotionRecord {
+	if x != nil {
+		return x.WitchPotions
+	}
+	return nil
+}
+
+func (x *PrivateKnowledge) GetGuardRecords() []*GuardRecord {
+	if x != nil {
+		return x.GuardRecords
+	}
+	return nil
+}
+
+func (x *PrivateKnowledge) GetLoverId() string {
+	if x != nil {
+		return x.LoverId
+	}
+	return ""
+}
+
+// 投票结果统计
+type VoteTally struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Counts        map[string]int32       `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // target_id -> 票数
+	EliminatedId  string                 `protobuf:"bytes,3,opt,name=eliminated_id,json=eliminatedId,proto3" json:"eliminated_id,omitempty"`                                            // 被放逐的玩家，平票或无人投票时为空
+	Ballots       []*Ballot              `protobuf:"bytes,4,rep,name=ballots,proto3" json:"ballots,omitempty"`                                                                          // 按座位号排列的投票明细，匿名模式下为空
+	Abstentions   int32                  `protobuf:"varint,5,opt,name=abstentions,proto3" json:"abstentions,omitempty"`                                                                 // 弃票数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteTally) Reset() {
+	*x = VoteTally{}
+	mi := &file_v1_werewolf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteTally) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteTally) ProtoMessage() {}
+
+func (x *VoteTally) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteTally.ProtoReflect.Descriptor instead.
+func (*VoteTally) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VoteTally) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *VoteTally) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *VoteTally) GetEliminatedId() string {
+	if x != nil {
+		return x.EliminatedId
+	}
+	return ""
+}
+
+func (x *VoteTally) GetBallots() []*Ballot {
+	if x != nil {
+		return x.Ballots
+	}
+	return nil
+}
+
+func (x *VoteTally) GetAbstentions() int32 {
+	if x != nil {
+		return x.Abstentions
+	}
+	return 0
+}
+
+// 聊天消息
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderId      string                 `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderName    string                 `protobuf:"bytes,2,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Channel       ChatChannel            `protobuf:"varint,3,opt,name=channel,proto3,enum=werewolf.v1.ChatChannel" json:"channel,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_v1_werewolf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChatMessage) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetChannel() ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ChatChannel_CHAT_CHANNEL_UNKNOWN
+}
+
+func (x *ChatMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// 发言轮次（严格发言模式）
+type SpeakerTurn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpeakerId     string                 `protobuf:"bytes,1,opt,name=speaker_id,json=speakerId,proto3" json:"speaker_id,omitempty"`
+	Deadline      int64                  `protobuf:"varint,2,opt,name=deadline,proto3" json:"deadline,omitempty"` // 本轮发言截止时间（Unix 秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpeakerTurn) Reset() {
+	*x = SpeakerTurn{}
+	mi := &file_v1_werewolf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeakerTurn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeakerTurn) ProtoMessage() {}
+
+func (x *SpeakerTurn) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeakerTurn.ProtoReflect.Descriptor instead.
+func (*SpeakerTurn) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SpeakerTurn) GetSpeakerId() string {
+	if x != nil {
+		return x.SpeakerId
+	}
+	return ""
+}
+
+func (x *SpeakerTurn) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+// 单张选票
+type Ballot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VoterId       string                 `protobuf:"bytes,1,opt,name=voter_id,json=voterId,proto3" json:"voter_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // 弃票时为空
+	Abstain       bool                   `protobuf:"varint,3,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ballot) Reset() {
+	*x = Ballot{}
+	mi := &file_v1_werewolf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ballot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ballot) ProtoMessage() {}
+
+func (x *Ballot) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ballot.ProtoReflect.Descriptor instead.
+func (*Ballot) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Ballot) GetVoterId() string {
+	if x != nil {
+		return x.VoterId
+	}
+	return ""
+}
+
+func (x *Ballot) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Ballot) GetAbstain() bool {
+	if x != nil {
+		return x.Abstain
+	}
+	return false
+}
+
+// 死亡通报
+type DeathReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	PlayerIds     []string               `protobuf:"bytes,2,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"` // 为空表示平安夜
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeathReport) Reset() {
+	*x = DeathReport{}
+	mi := &file_v1_werewolf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeathReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeathReport) ProtoMessage() {}
+
+func (x *DeathReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeathReport.ProtoReflect.Descriptor instead.
+func (*DeathReport) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeathReport) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *DeathReport) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+// 游戏结束信息
+type GameOverInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Winner        Camp                   `protobuf:"varint,1,opt,name=winner,proto3,enum=werewolf.v1.Camp" json:"winner,omitempty"`
+	Report        *GameReport            `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"` // 游戏结束时公开全部信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameOverInfo) Reset() {
+	*x = GameOverInfo{}
+	mi := &file_v1_werewolf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameOverInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOverInfo) ProtoMessage() {}
+
+func (x *GameOverInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOverInfo.ProtoReflect.Descriptor instead.
+func (*GameOverInfo) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GameOverInfo) GetWinner() Camp {
+	if x != nil {
+		return x.Winner
+	}
+	return Camp_CAMP_UNKNOWN
+}
+
+func (x *GameOverInfo) GetReport() *GameReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// 玩家身份揭晓
+type PlayerReveal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        *Player                `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"` // 完整的玩家信息，包括身份和阵营
+	DeathCause    DeathCause             `protobuf:"varint,2,opt,name=death_cause,json=deathCause,proto3,enum=werewolf.v1.DeathCause" json:"death_cause,omitempty"`
+	DeathDay      int32                  `protobuf:"varint,3,opt,name=death_day,json=deathDay,proto3" json:"death_day,omitempty"` // 死亡的天数，存活为 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerReveal) Reset() {
+	*x = PlayerReveal{}
+	mi := &file_v1_werewolf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerReveal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerReveal) ProtoMessage() {}
+
+func (x *PlayerReveal) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerReveal.ProtoReflect.Descriptor instead.
+func (*PlayerReveal) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PlayerReveal) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *PlayerReveal) GetDeathCause() DeathCause {
+	if x != nil {
+		return x.DeathCause
+	}
+	return DeathCause_DEATH_CAUSE_NONE
+}
+
+func (x *PlayerReveal) GetDeathDay() int32 {
+	if x != nil {
+		return x.DeathDay
+	}
+	return 0
+}
+
+// 时间线条目：夜晚行动、投票或死亡
+type TimelineEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Phase         Phase                  `protobuf:"varint,2,opt,name=phase,proto3,enum=werewolf.v1.Phase" json:"phase,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`                                       // 行动者或投票者，死亡记录为空
+	Action        ActionType             `protobuf:"varint,4,opt,name=action,proto3,enum=werewolf.v1.ActionType" json:"action,omitempty"`                           // 夜晚行动或投票，死亡记录为 ACTION_UNKNOWN
+	TargetId      string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`                                    // 行动目标或死亡的玩家
+	DeathCause    DeathCause             `protobuf:"varint,6,opt,name=death_cause,json=deathCause,proto3,enum=werewolf.v1.DeathCause" json:"death_cause,omitempty"` // 死亡记录的死因
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEntry) Reset() {
+	*x = TimelineEntry{}
+	mi := &file_v1_werewolf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEntry) ProtoMessage() {}
+
+func (x *TimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEntry.ProtoReflect.Descriptor instead.
+func (*TimelineEntry) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TimelineEntry) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *TimelineEntry) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_PHASE_WAITING
+}
+
+func (x *TimelineEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ACTION_UNKNOWN
+}
+
+func (x *TimelineEntry) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetDeathCause() DeathCause {
+	if x != nil {
+		return x.DeathCause
+	}
+	return DeathCause_DEATH_CAUSE_NONE
+}
+
+func (x *TimelineEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// 复盘报告
+type GameReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName      string                 `protobuf:"bytes,2,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	Winner        Camp                   `protobuf:"varint,3,opt,name=winner,proto3,enum=werewolf.v1.Camp" json:"winner,omitempty"`
+	DayCount      int32                  `protobuf:"varint,4,opt,name=day_count,json=dayCount,proto3" json:"day_count,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"` // Unix 秒
+	FinishedAt    int64                  `protobuf:"varint,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Players       []*PlayerReveal        `protobuf:"bytes,7,rep,name=players,proto3" json:"players,omitempty"`   // 按座位号排列
+	Timeline      []*TimelineEntry       `protobuf:"bytes,8,rep,name=timeline,proto3" json:"timeline,omitempty"` // 按时间顺序排列
+	Votes         []*VoteTally           `protobuf:"bytes,9,rep,name=votes,proto3" json:"votes,omitempty"`       // 历次投票结果，匿名模式下每个人的投票见时间线
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameReport) Reset() {
+	*x = GameReport{}
+	mi := &file_v1_werewolf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameReport) ProtoMessage() {}
+
+func (x *GameReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameReport.ProtoReflect.Descriptor instead.
+func (*GameReport) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GameReport) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GameReport) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *GameReport) GetWinner() Camp {
+	if x != nil {
+		return x.Winner
+	}
+	return Camp_CAMP_UNKNOWN
+}
+
+func (x *GameReport) GetDayCount() int32 {
+	if x != nil {
+		return x.DayCount
+	}
+	return 0
+}
+
+func (x *GameReport) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *GameReport) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *GameReport) GetPlayers() []*PlayerReveal {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameReport) GetTimeline() []*TimelineEntry {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+func (x *GameReport) GetVotes() []*VoteTally {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+// 身份可见性配置（按房间）
+// 狼人互相可见、情侣互相可见始终生效
+type VisibilityConfig struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DeadGodView          bool                   `protobuf:"varint,1,opt,name=dead_god_view,json=deadGodView,proto3" json:"dead_god_view,omitempty"`                            // 死亡玩家获得上帝视角
+	SpectatorGodView     bool                   `protobuf:"varint,2,opt,name=spectator_god_view,json=spectatorGodView,proto3" json:"spectator_god_view,omitempty"`             // 允许观战者开启上帝视角，事件按 spectator_delay_phases 延迟推送，防止向存活玩家透露信息
+	SpectatorDelayPhases int32                  `protobuf:"varint,3,opt,name=spectator_delay_phases,json=spectatorDelayPhases,proto3" json:"spectator_delay_phases,omitempty"` // 上帝视角延迟的阶段数，0 表示使用默认值
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *VisibilityConfig) Reset() {
+	*x = VisibilityConfig{}
+	mi := &file_v1_werewolf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisibilityConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisibilityConfig) ProtoMessage() {}
+
+func (x *VisibilityConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisibilityConfig.ProtoReflect.Descriptor instead.
+func (*VisibilityConfig) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VisibilityConfig) GetDeadGodView() bool {
+	if x != nil {
+		return x.DeadGodView
+	}
+	return false
+}
+
+func (x *VisibilityConfig) GetSpectatorGodView() bool {
+	if x != nil {
+		return x.SpectatorGodView
+	}
+	return false
+}
+
+func (x *VisibilityConfig) GetSpectatorDelayPhases() int32 {
+	if x != nil {
+		return x.SpectatorDelayPhases
+	}
+	return 0
+}
+
+// 阶段超时处理策略（按房间）
+type TimeoutPolicy struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	WerewolfRandomKill bool                   `protobuf:"varint,1,opt,name=werewolf_random_kill,json=werewolfRandomKill,proto3" json:"werewolf_random_kill,omitempty"` // 狼人超时未选定目标时随机击杀一名非狼人玩家
+	AfkThreshold       int32                  `protobuf:"varint,2,opt,name=afk_threshold,json=afkThreshold,proto3" json:"afk_threshold,omitempty"`                     // 连续超时达到该次数标记为挂机，0 表示使用默认值
+	BotTakeover        bool                   `protobuf:"varint,3,opt,name=bot_takeover,json=botTakeover,proto3" json:"bot_takeover,omitempty"`                        // 挂机后由机器人托管座位
+	VoteRandomTarget   bool                   `protobuf:"varint,4,opt,name=vote_random_target,json=voteRandomTarget,proto3" json:"vote_random_target,omitempty"`       // 投票超时未投票的玩家随机投给一名其他存活玩家，默认视为弃票
+	SeerRandomCheck    bool                   `protobuf:"varint,5,opt,name=seer_random_check,json=seerRandomCheck,proto3" json:"seer_random_check,omitempty"`          // 预言家超时随机查验一名其他存活玩家，默认本晚没有查验结果
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TimeoutPolicy) Reset() {
+	*x = TimeoutPolicy{}
+	mi := &file_v1_werewolf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeoutPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeoutPolicy) ProtoMessage() {}
+
+func (x *TimeoutPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeoutPolicy.ProtoReflect.Descriptor instead.
+func (*TimeoutPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TimeoutPolicy) GetWerewolfRandomKill() bool {
+	if x != nil {
+		return x.WerewolfRandomKill
+	}
+	return false
+}
+
+func (x *TimeoutPolicy) GetAfkThreshold() int32 {
+	if x != nil {
+		return x.AfkThreshold
+	}
+	return 0
+}
+
+func (x *TimeoutPolicy) GetBotTakeover() bool {
+	if x != nil {
+		return x.BotTakeover
+	}
+	return false
+}
+
+func (x *TimeoutPolicy) GetVoteRandomTarget() bool {
+	if x != nil {
+		return x.VoteRandomTarget
+	}
+	return false
+}
+
+func (x *TimeoutPolicy) GetSeerRandomCheck() bool {
+	if x != nil {
+		return x.SeerRandomCheck
+	}
+	return false
+}
+
+// 创建游戏房间请求
+type CreateRoomRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RoomName       string                 `protobuf:"bytes,1,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	MaxPlayers     int32                  `protobuf:"varint,2,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	RoleConfig     map[string]int32       `protobuf:"bytes,3,rep,name=role_config,json=roleConfig,proto3" json:"role_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Visibility     *VisibilityConfig      `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	TimeoutPolicy  *TimeoutPolicy         `protobuf:"bytes,5,opt,name=timeout_policy,json=timeoutPolicy,proto3" json:"timeout_policy,omitempty"`
+	VoteMode       VoteMode               `protobuf:"varint,6,opt,name=vote_mode,json=voteMode,proto3,enum=werewolf.v1.VoteMode" json:"vote_mode,omitempty"`
+	StrictSpeaking bool                   `protobuf:"varint,7,opt,name=strict_speaking,json=strictSpeaking,proto3" json:"strict_speaking,omitempty"` // 严格发言模式：白天按座位号轮流发言，只有当前发言者可以在公共频道发言
+	CreatorId      string                 `protobuf:"bytes,8,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`                 // 房主，未入座时也可以开始游戏
+	RandomLovers   bool                   `protobuf:"varint,9,opt,name=random_lovers,json=randomLovers,proto3" json:"random_lovers,omitempty"`       // 开局随机指定两名玩家结为情侣，情侣互相可见身份
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateRoomRequest) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetRoleConfig() map[string]int32 {
+	if x != nil {
+		return x.RoleConfig
+	}
+	return nil
+}
+
+func (x *CreateRoomRequest) GetVisibility() *VisibilityConfig {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+func (x *CreateRoomRequest) GetTimeoutPolicy() *TimeoutPolicy {
+	if x != nil {
+		return x.TimeoutPolicy
+	}
+	return nil
+}
+
+func (x *CreateRoomRequest) GetVoteMode() VoteMode {
+	if x != nil {
+		return x.VoteMode
+	}
+	return VoteMode_VOTE_MODE_OPEN
+}
+
+func (x *CreateRoomRequest) GetStrictSpeaking() bool {
+	if x != nil {
+		return x.StrictSpeaking
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetRandomLovers() bool {
+	if x != nil {
+		return x.RandomLovers
+	}
+	return false
+}
+
+type CreateRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomResponse) Reset() {
+	*x = CreateRoomResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomResponse) ProtoMessage() {}
+
+func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateRoomResponse) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateRoomResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 加入房间请求
+type JoinRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Spectate      bool                   `protobuf:"varint,4,opt,name=spectate,proto3" json:"spectate,omitempty"`              // 以观战者身份加入，不占座位
+	GodView       bool                   `protobuf:"varint,5,opt,name=god_view,json=godView,proto3" json:"god_view,omitempty"` // 观战者开启延迟上帝视角，需要房间允许
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRoomRequest) Reset() {
+	*x = JoinRoomRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomRequest) ProtoMessage() {}
+
+func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *JoinRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetSpectate() bool {
+	if x != nil {
+		return x.Spectate
+	}
+	return false
+}
+
+func (x *JoinRoomRequest) GetGodView() bool {
+	if x != nil {
+		return x.GodView
+	}
+	return false
+}
+
+type JoinRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Player        *Player                `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	Reason        ErrorReason            `protobuf:"varint,4,opt,name=reason,proto3,enum=werewolf.v1.ErrorReason" json:"reason,omitempty"` // success 为 false 时的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRoomResponse) Reset() {
+	*x = JoinRoomResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomResponse) ProtoMessage() {}
+
+func (x *JoinRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomResponse.ProtoReflect.Descriptor instead.
+func (*JoinRoomResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *JoinRoomResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *JoinRoomResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JoinRoomResponse) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *JoinRoomResponse) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+// 开始游戏请求
+type StartGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // 发起者，须为已入座的玩家或房主
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameRequest) Reset() {
+	*x = StartGameRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRequest) ProtoMessage() {}
+
+func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
+func (*StartGameRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StartGameRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type StartGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PhaseInfo     *PhaseInfo             `protobuf:"bytes,3,opt,name=phase_info,json=phaseInfo,proto3" json:"phase_info,omitempty"`
+	Reason        ErrorReason            `protobuf:"varint,4,opt,name=reason,proto3,enum=werewolf.v1.ErrorReason" json:"reason,omitempty"` // success 为 false 时的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameResponse) Reset() {
+	*x = StartGameResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameResponse) ProtoMessage() {}
+
+func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameResponse.ProtoReflect.Descriptor instead.
+func (*StartGameResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StartGameResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StartGameResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StartGameResponse) GetPhaseInfo() *PhaseInfo {
+	if x != nil {
+		return x.PhaseInfo
+	}
+	return nil
+}
+
+func (x *StartGameResponse) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+// 夜晚行动请求
+type NightActionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RoomId         string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId       string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TargetPlayerId string                 `protobuf:"bytes,3,opt,name=target_player_id,json=targetPlayerId,proto3" json:"target_player_id,omitempty"`
+	// Deprecated: Marked as deprecated in v1/werewolf.proto.
+	ActionType    string     `protobuf:"bytes,4,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"` // 使用 action
+	Action        ActionType `protobuf:"varint,5,opt,name=action,proto3,enum=werewolf.v1.ActionType" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NightActionRequest) Reset() {
+	*x = NightActionRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NightActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NightActionRequest) ProtoMessage() {}
+
+func (x *NightActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NightActionRequest.ProtoReflect.Descriptor instead.
+func (*NightActionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NightActionRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *NightActionRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *NightActionRequest) GetTargetPlayerId() string {
+	if x != nil {
+		return x.TargetPlayerId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in v1/werewolf.proto.
+func (x *NightActionRequest) GetActionType() string {
+	if x != nil {
+		return x.ActionType
+	}
+	return ""
+}
+
+func (x *NightActionRequest) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ACTION_UNKNOWN
+}
+
+type NightActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Result        string                 `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	SeerResult    *SeerResult            `protobuf:"bytes,4,opt,name=seer_result,json=seerResult,proto3" json:"seer_result,omitempty"`     // 预言家查验时返回
+	Reason        ErrorReason            `protobuf:"varint,5,opt,name=reason,proto3,enum=werewolf.v1.ErrorReason" json:"reason,omitempty"` // success 为 false 时的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NightActionResponse) Reset() {
+	*x = NightActionResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NightActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NightActionResponse) ProtoMessage() {}
+
+func (x *NightActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NightActionResponse.ProtoReflect.Descriptor instead.
+func (*NightActionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NightActionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NightActionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NightActionResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *NightActionResponse) GetSeerResult() *SeerResult {
+	if x != nil {
+		return x.SeerResult
+	}
+	return nil
+}
+
+func (x *NightActionResponse) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+// 投票请求
+type VoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	VoterId       string                 `protobuf:"bytes,2,opt,name=voter_id,json=voterId,proto3" json:"voter_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Abstain       bool                   `protobuf:"varint,4,opt,name=abstain,proto3" json:"abstain,omitempty"` // 弃票，此时忽略 target_id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteRequest) Reset() {
+	*x = VoteRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteRequest) ProtoMessage() {}
+
+func (x *VoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
+func (*VoteRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VoteRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *VoteRequest) GetVoterId() string {
+	if x != nil {
+		return x.VoterId
+	}
+	return ""
+}
+
+func (x *VoteRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *VoteRequest) GetAbstain() bool {
+	if x != nil {
+		return x.Abstain
+	}
+	return false
+}
+
+type VoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reason        ErrorReason            `protobuf:"varint,3,opt,name=reason,proto3,enum=werewolf.v1.ErrorReason" json:"reason,omitempty"` // success 为 false 时的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteResponse) Reset() {
+	*x = VoteResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteResponse) ProtoMessage() {}
+
+func (x *VoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteResponse.ProtoReflect.Descriptor instead.
+func (*VoteResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VoteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VoteResponse) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+// 获取游戏状态请求
+type GetGameStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Spectator     bool                   `protobuf:"varint,3,opt,name=spectator,proto3" json:"spectator,omitempty"`            // player_id 为观战者ID，与玩家ID互不冲突
+	LastSeq       int64                  `protobuf:"varint,4,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"` // 仅用于 SubscribeGameEvents：先补发序号大于该值的历史事件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameStateRequest) Reset() {
+	*x = GetGameStateRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStateRequest) ProtoMessage() {}
+
+func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStateRequest.ProtoReflect.Descriptor instead.
+func (*GetGameStateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetGameStateRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetGameStateRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetGameStateRequest) GetSpectator() bool {
+	if x != nil {
+		return x.Spectator
+	}
+	return false
+}
+
+func (x *GetGameStateRequest) GetLastSeq() int64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+type GetGameStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	State         GameState              `protobuf:"varint,2,opt,name=state,proto3,enum=werewolf.v1.GameState" json:"state,omitempty"`
+	PhaseInfo     *PhaseInfo             `protobuf:"bytes,3,opt,name=phase_info,json=phaseInfo,proto3" json:"phase_info,omitempty"`
+	Players       []*Player              `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	DayCount      int32                  `protobuf:"varint,5,opt,name=day_count,json=dayCount,proto3" json:"day_count,omitempty"`
+	CurrentPlayer *Player                `protobuf:"bytes,6,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"` // 当前玩家的完整信息
+	Knowledge     *PrivateKnowledge      `protobuf:"bytes,7,opt,name=knowledge,proto3" json:"knowledge,omitempty"`                              // 当前玩家的私有信息
+	VoteHistory   []*VoteTally           `protobuf:"bytes,8,rep,name=vote_history,json=voteHistory,proto3" json:"vote_history,omitempty"`       // 历次投票结果，按天数排列
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameStateResponse) Reset() {
+	*x = GetGameStateResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStateResponse) ProtoMessage() {}
+
+func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStateResponse.ProtoReflect.Descriptor instead.
+func (*GetGameStateResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetGameStateResponse) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetGameStateResponse) GetState() GameState {
+	if x != nil {
+		return x.State
+	}
+	return GameState_WAITING
+}
+
+func (x *GetGameStateResponse) GetPhaseInfo() *PhaseInfo {
+	if x != nil {
+		return x.PhaseInfo
+	}
+	return nil
+}
+
+func (x *GetGameStateResponse) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GetGameStateResponse) GetDayCount() int32 {
+	if x != nil {
+		return x.DayCount
+	}
+	return 0
+}
+
+func (x *GetGameStateResponse) GetCurrentPlayer() *Player {
+	if x != nil {
+		return x.CurrentPlayer
+	}
+	return nil
+}
+
+func (x *GetGameStateResponse) GetKnowledge() *PrivateKnowledge {
+	if x != nil {
+		return x.Knowledge
+	}
+	return nil
+}
+
+func (x *GetGameStateResponse) GetVoteHistory() []*VoteTally {
+	if x != nil {
+		return x.VoteHistory
+	}
+	return nil
+}
+
+// 获取可执行行动请求
+type GetAvailableActionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableActionsRequest) Reset() {
+	*x = GetAvailableActionsRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableActionsRequest) ProtoMessage() {}
+
+func (x *GetAvailableActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableActionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailableActionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetAvailableActionsRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetAvailableActionsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetAvailableActionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Phase         Phase                  `protobuf:"varint,3,opt,name=phase,proto3,enum=werewolf.v1.Phase" json:"phase,omitempty"`
+	Actions       []*AvailableAction     `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	Deadline      int64                  `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"` // 阶段截止时间（Unix 秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableActionsResponse) Reset() {
+	*x = GetAvailableActionsResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableActionsResponse) ProtoMessage() {}
+
+func (x *GetAvailableActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableActionsResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailableActionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetAvailableActionsResponse) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetAvailableActionsResponse) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetAvailableActionsResponse) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_PHASE_WAITING
+}
+
+func (x *GetAvailableActionsResponse) GetActions() []*AvailableAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *GetAvailableActionsResponse) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+// 游戏事件
+type GameEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventType       GameEvent_EventType    `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=werewolf.v1.GameEvent_EventType" json:"event_type,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PhaseInfo       *PhaseInfo             `protobuf:"bytes,3,opt,name=phase_info,json=phaseInfo,proto3" json:"phase_info,omitempty"`
+	AffectedPlayers []*Player              `protobuf:"bytes,4,rep,name=affected_players,json=affectedPlayers,proto3" json:"affected_players,omitempty"`
+	Timestamp       int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Deprecated: Marked as deprecated in v1/werewolf.proto.
+	ExtraData        map[string]string  `protobuf:"bytes,6,rep,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 使用 payload
+	AvailableActions []*AvailableAction `protobuf:"bytes,7,rep,name=available_actions,json=availableActions,proto3" json:"available_actions,omitempty"`                                                      // EVENT_YOUR_TURN 时下发
+	Deadline         int64              `protobuf:"varint,8,opt,name=deadline,proto3" json:"deadline,omitempty"`                                                                                             // 阶段截止时间（Unix 秒）
+	Seq              int64              `protobuf:"varint,9,opt,name=seq,proto3" json:"seq,omitempty"`                                                                                                       // 房间内递增的事件序号，用于确认和断线补发
+	// 按事件类型携带的结构化数据
+	//
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*GameEvent_WitchPrompt
+	//	*GameEvent_SeerResult
+	//	*GameEvent_VoteTally
+	//	*GameEvent_DeathReport
+	//	*GameEvent_GameOver
+	//	*GameEvent_WitchPotion
+	//	*GameEvent_GuardRecord
+	//	*GameEvent_Chat
+	//	*GameEvent_SpeakerTurn
+	Payload       isGameEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
+	mi := &file_v1_werewolf_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEvent) ProtoMessage() {}
+
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GameEvent) GetEventType() GameEvent_EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return GameEvent_EVENT_UNKNOWN
+}
+
+func (x *GameEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GameEvent) GetPhaseInfo() *PhaseInfo {
+	if x != nil {
+		return x.PhaseInfo
+	}
+	return nil
+}
+
+func (x *GameEvent) GetAffectedPlayers() []*Player {
+	if x != nil {
+		return x.AffectedPlayers
+	}
+	return nil
+}
+
+func (x *GameEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in v1/werewolf.proto.
+func (x *GameEvent) GetExtraData() map[string]string {
+	if x != nil {
+		return x.ExtraData
+	}
+	return nil
+}
+
+func (x *GameEvent) GetAvailableActions() []*AvailableAction {
+	if x != nil {
+		return x.AvailableActions
+	}
+	return nil
+}
+
+func (x *GameEvent) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *GameEvent) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GameEvent) GetPayload() isGameEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GameEvent) GetWitchPrompt() *WitchPrompt {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_WitchPrompt); ok {
+			return x.WitchPrompt
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetSeerResult() *SeerResult {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_SeerResult); ok {
+			return x.SeerResult
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetVoteTally() *VoteTally {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_VoteTally); ok {
+			return x.VoteTally
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetDeathReport() *DeathReport {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_DeathReport); ok {
+			return x.DeathReport
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetGameOver() *GameOverInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_GameOver); ok {
+			return x.GameOver
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetWitchPotion() *WitchPotionRecord {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_WitchPotion); ok {
+			return x.WitchPotion
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetGuardRecord() *GuardRecord {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_GuardRecord); ok {
+			return x.GuardRecord
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetChat() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_Chat); ok {
+			return x.Chat
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetSpeakerTurn() *SpeakerTurn {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_SpeakerTurn); ok {
+			return x.SpeakerTurn
+		}
+	}
+	return nil
+}
+
+type isGameEvent_Payload interface {
+	isGameEvent_Payload()
+}
+
+type GameEvent_WitchPrompt struct {
+	WitchPrompt *WitchPrompt `protobuf:"bytes,10,opt,name=witch_prompt,json=witchPrompt,proto3,oneof"`
+}
+
+type GameEvent_SeerResult struct {
+	SeerResult *SeerResult `protobuf:"bytes,11,opt,name=seer_result,json=seerResult,proto3,oneof"`
+}
+
+type GameEvent_VoteTally struct {
+	VoteTally *VoteTally `protobuf:"bytes,12,opt,name=vote_tally,json=voteTally,proto3,oneof"`
+}
+
+type GameEvent_DeathReport struct {
+	DeathReport *DeathReport `protobuf:"bytes,13,opt,name=death_report,json=deathReport,proto3,oneof"`
+}
+
+type GameEvent_GameOver struct {
+	GameOver *GameOverInfo `protobuf:"bytes,14,opt,name=game_over,json=gameOver,proto3,oneof"`
+}
+
+type GameEvent_WitchPotion struct {
+	WitchPotion *WitchPotionRecord `protobuf:"bytes,15,opt,name=witch_potion,json=witchPotion,proto3,oneof"`
+}
+
+type GameEvent_GuardRecord struct {
+	GuardRecord *GuardRecord `protobuf:"bytes,16,opt,name=guard_record,json=guardRecord,proto3,oneof"`
+}
+
+type GameEvent_Chat struct {
+	Chat *ChatMessage `protobuf:"bytes,17,opt,name=chat,proto3,oneof"`
+}
+
+type GameEvent_SpeakerTurn struct {
+	SpeakerTurn *SpeakerTurn `protobuf:"bytes,18,opt,name=speaker_turn,json=speakerTurn,proto3,oneof"`
+}
+
+func (*GameEvent_WitchPrompt) isGameEvent_Payload() {}
+
+func (*GameEvent_SeerResult) isGameEvent_Payload() {}
+
+func (*GameEvent_VoteTally) isGameEvent_Payload() {}
+
+func (*GameEvent_DeathReport) isGameEvent_Payload() {}
+
+func (*GameEvent_GameOver) isGameEvent_Payload() {}
+
+func (*GameEvent_WitchPotion) isGameEvent_Payload() {}
+
+func (*GameEvent_GuardRecord) isGameEvent_Payload() {}
+
+func (*GameEvent_Chat) isGameEvent_Payload() {}
+
+func (*GameEvent_SpeakerTurn) isGameEvent_Payload() {}
+
+// 发送聊天消息请求
+type SendChatMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Channel       ChatChannel            `protobuf:"varint,3,opt,name=channel,proto3,enum=werewolf.v1.ChatChannel" json:"channel,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatMessageRequest) Reset() {
+	*x = SendChatMessageRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageRequest) ProtoMessage() {}
+
+func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SendChatMessageRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetChannel() ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ChatChannel_CHAT_CHANNEL_UNKNOWN
+}
+
+func (x *SendChatMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type SendChatMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reason        ErrorReason            `protobuf:"varint,3,opt,name=reason,proto3,enum=werewolf.v1.ErrorReason" json:"reason,omitempty"` // success 为 false 时的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatMessageResponse) Reset() {
+	*x = SendChatMessageResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageResponse) ProtoMessage() {}
+
+func (x *SendChatMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendChatMessageResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SendChatMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendChatMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendChatMessageResponse) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+// 会话加入：已入座的玩家直接接入（断线重连），未入座时提供 player_name 则入座，否则以观战者身份接入
+type SessionJoin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	LastSeq       int64                  `protobuf:"varint,4,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`          // 最后收到的事件序号，服务端补发之后的事件；为 0 时从上次确认的位置补发
+	Spectate      bool                   `protobuf:"varint,5,opt,name=spectate,proto3" json:"spectate,omitempty"`                       // 以观战者身份接入
+	GodView       bool                   `protobuf:"varint,6,opt,name=god_view,json=godView,proto3" json:"god_view,omitempty"`          // 观战者开启延迟上帝视角
+	SkipEvents    bool                   `protobuf:"varint,7,opt,name=skip_events,json=skipEvents,proto3" json:"skip_events,omitempty"` // 不推送实时事件，只处理请求和补发；实时事件由网关的房间订阅统一分发
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionJoin) Reset() {
+	*x = SessionJoin{}
+	mi := &file_v1_werewolf_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionJoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionJoin) ProtoMessage() {}
+
+func (x *SessionJoin) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionJoin.ProtoReflect.Descriptor instead.
+func (*SessionJoin) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SessionJoin) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SessionJoin) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SessionJoin) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *SessionJoin) GetLastSeq() int64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+func (x *SessionJoin) GetSpectate() bool {
+	if x != nil {
+		return x.Spectate
+	}
+	return false
+}
+
+func (x *SessionJoin) GetGodView() bool {
+	if x != nil {
+		return x.GodView
+	}
+	return false
+}
+
+func (x *SessionJoin) GetSkipEvents() bool {
+	if x != nil {
+		return x.SkipEvents
+	}
+	return false
+}
+
+// 事件确认
+type SessionAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"` // 已处理到的事件序号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionAck) Reset() {
+	*x = SessionAck{}
+	mi := &file_v1_werewolf_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAck) ProtoMessage() {}
+
+func (x *SessionAck) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAck.ProtoReflect.Descriptor instead.
+func (*SessionAck) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SessionAck) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// 切换准备状态，只能在游戏开始前使用
+type SessionReady struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ready         bool                   `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionReady) Reset() {
+	*x = SessionReady{}
+	mi := &file_v1_werewolf_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionReady) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionReady) ProtoMessage() {}
+
+func (x *SessionReady) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionReady.ProtoReflect.Descriptor instead.
+func (*SessionReady) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SessionReady) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+// 当前发言者提前结束发言，包括遗言
+type SessionEndSpeech struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionEndSpeech) Reset() {
+	*x = SessionEndSpeech{}
+	mi := &file_v1_werewolf_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionEndSpeech) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEndSpeech) ProtoMessage() {}
+
+func (x *SessionEndSpeech) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEndSpeech.ProtoReflect.Descriptor instead.
+func (*SessionEndSpeech) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{41}
+}
+
+// 准备、结束发言等会话内操作的结果
+type SessionActionResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reason        ErrorReason            `protobuf:"varint,3,opt,name=reason,proto3,enum=werewolf.v1.ErrorReason" json:"reason,omitempty"` // success 为 false 时的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionActionResult) Reset() {
+	*x = SessionActionResult{}
+	mi := &file_v1_werewolf_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionActionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionActionResult) ProtoMessage() {}
+
+func (x *SessionActionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionActionResult.ProtoReflect.Descriptor instead.
+func (*SessionActionResult) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SessionActionResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SessionActionResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SessionActionResult) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+// 会话错误
+type SessionError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // gRPC 状态码名称，如 NotFound
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reason        ErrorReason            `protobuf:"varint,3,opt,name=reason,proto3,enum=werewolf.v1.ErrorReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionError) Reset() {
+	*x = SessionError{}
+	mi := &file_v1_werewolf_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionError) ProtoMessage() {}
+
+func (x *SessionError) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionError.ProtoReflect.Descriptor instead.
+func (*SessionError) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SessionError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SessionError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SessionError) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+// 客户端发往会话的消息
+// 加入后房间和玩家由会话确定，行动请求中的 room_id 和 player_id 会被忽略
+type SessionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // 关联ID，对应的响应原样带回
+	// Types that are valid to be assigned to Request:
+	//
+	//	*SessionRequest_Join
+	//	*SessionRequest_NightAction
+	//	*SessionRequest_Vote
+	//	*SessionRequest_Chat
+	//	*SessionRequest_Ack
+	//	*SessionRequest_Ready
+	//	*SessionRequest_EndSpeech
+	Request       isSessionRequest_Request `protobuf_oneof:"request"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionRequest) Reset() {
+	*x = SessionRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRequest) ProtoMessage() {}
+
+func (x *SessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRequest.ProtoReflect.Descriptor instead.
+func (*SessionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SessionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SessionRequest) GetRequest() isSessionRequest_Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *SessionRequest) GetJoin() *SessionJoin {
+	if x != nil {
+		if x, ok := x.Request.(*SessionRequest_Join); ok {
+			return x.Join
+		}
+	}
+	return nil
+}
+
+func (x *SessionRequest) GetNightAction() *NightActionRequest {
+	if x != nil {
+		if x, ok := x.Request.(*SessionRequest_NightAction); ok {
+			return x.NightAction
+		}
+	}
+	return nil
+}
+
+func (x *SessionRequest) GetVote() *VoteRequest {
+	if x != nil {
+		if x, ok := x.Request.(*SessionRequest_Vote); ok {
+			return x.Vote
+		}
+	}
+	return nil
+}
+
+func (x *SessionRequest) GetChat() *SendChatMessageRequest {
+	if x != nil {
+		if x, ok := x.Request.(*SessionRequest_Chat); ok {
+			return x.Chat
+		}
+	}
+	return nil
+}
+
+func (x *SessionRequest) GetAck() *SessionAck {
+	if x != nil {
+		if x, ok := x.Request.(*SessionRequest_Ack); ok {
+			return x.Ack
+		}
+	}
+	return nil
+}
+
+func (x *SessionRequest) GetReady() *SessionReady {
+	if x != nil {
+		if x, ok := x.Request.(*SessionRequest_Ready); ok {
+			return x.Ready
+		}
+	}
+	return nil
+}
+
+func (x *SessionRequest) GetEndSpeech() *SessionEndSpeech {
+	if x != nil {
+		if x, ok := x.Request.(*SessionRequest_EndSpeech); ok {
+			return x.EndSpeech
+		}
+	}
+	return nil
+}
+
+type isSessionRequest_Request interface {
+	isSessionRequest_Request()
+}
+
+type SessionRequest_Join struct {
+	Join *SessionJoin `protobuf:"bytes,10,opt,name=join,proto3,oneof"`
+}
+
+type SessionRequest_NightAction struct {
+	NightAction *NightActionRequest `protobuf:"bytes,11,opt,name=night_action,json=nightAction,proto3,oneof"`
+}
+
+type SessionRequest_Vote struct {
+	Vote *VoteRequest `protobuf:"bytes,12,opt,name=vote,proto3,oneof"`
+}
+
+type SessionRequest_Chat struct {
+	Chat *SendChatMessageRequest `protobuf:"bytes,13,opt,name=chat,proto3,oneof"`
+}
+
+type SessionRequest_Ack struct {
+	Ack *SessionAck `protobuf:"bytes,14,opt,name=ack,proto3,oneof"`
+}
+
+type SessionRequest_Ready struct {
+	Ready *SessionReady `protobuf:"bytes,15,opt,name=ready,proto3,oneof"`
+}
+
+type SessionRequest_EndSpeech struct {
+	EndSpeech *SessionEndSpeech `protobuf:"bytes,16,opt,name=end_speech,json=endSpeech,proto3,oneof"`
+}
+
+func (*SessionRequest_Join) isSessionRequest_Request() {}
+
+func (*SessionRequest_NightAction) isSessionRequest_Request() {}
+
+func (*SessionRequest_Vote) isSessionRequest_Request() {}
+
+func (*SessionRequest_Chat) isSessionRequest_Request() {}
+
+func (*SessionRequest_Ack) isSessionRequest_Request() {}
+
+func (*SessionRequest_Ready) isSessionRequest_Request() {}
+
+func (*SessionRequest_EndSpeech) isSessionRequest_Request() {}
+
+// 会话推送给客户端的消息
+type SessionResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // 对应请求的关联ID，服务端主动推送的事件为空
+	// Types that are valid to be assigned to Response:
+	//
+	//	*SessionResponse_Event
+	//	*SessionResponse_Join
+	//	*SessionResponse_NightAction
+	//	*SessionResponse_Vote
+	//	*SessionResponse_Chat
+	//	*SessionResponse_Error
+	//	*SessionResponse_Ready
+	//	*SessionResponse_EndSpeech
+	Response      isSessionResponse_Response `protobuf_oneof:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionResponse) Reset() {
+	*x = SessionResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionResponse) ProtoMessage() {}
+
+func (x *SessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionResponse.ProtoReflect.Descriptor instead.
+func (*SessionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SessionResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SessionResponse) GetResponse() isSessionResponse_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SessionResponse) GetEvent() *GameEvent {
+	if x != nil {
+		if x, ok := x.Response.(*SessionResponse_Event); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+func (x *SessionResponse) GetJoin() *JoinRoomResponse {
+	if x != nil {
+		if x, ok := x.Response.(*SessionResponse_Join); ok {
+			return x.Join
+		}
+	}
+	return nil
+}
+
+func (x *SessionResponse) GetNightAction() *NightActionResponse {
+	if x != nil {
+		if x, ok := x.Response.(*SessionResponse_NightAction); ok {
+			return x.NightAction
+		}
+	}
+	return nil
+}
+
+func (x *SessionResponse) GetVote() *VoteResponse {
+	if x != nil {
+		if x, ok := x.Response.(*SessionResponse_Vote); ok {
+			return x.Vote
+		}
+	}
+	return nil
+}
+
+func (x *SessionResponse) GetChat() *SendChatMessageResponse {
+	if x != nil {
+		if x, ok := x.Response.(*SessionResponse_Chat); ok {
+			return x.Chat
+		}
+	}
+	return nil
+}
+
+func (x *SessionResponse) GetError() *SessionError {
+	if x != nil {
+		if x, ok := x.Response.(*SessionResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *SessionResponse) GetReady() *SessionActionResult {
+	if x != nil {
+		if x, ok := x.Response.(*SessionResponse_Ready); ok {
+			return x.Ready
+		}
+	}
+	return nil
+}
+
+func (x *SessionResponse) GetEndSpeech() *SessionActionResult {
+	if x != nil {
+		if x, ok := x.Response.(*SessionResponse_EndSpeech); ok {
+			return x.EndSpeech
+		}
+	}
+	return nil
+}
+
+type isSessionResponse_Response interface {
+	isSessionResponse_Response()
+}
+
+type SessionResponse_Event struct {
+	Event *GameEvent `protobuf:"bytes,10,opt,name=event,proto3,oneof"`
+}
+
+type SessionResponse_Join struct {
+	Join *JoinRoomResponse `protobuf:"bytes,11,opt,name=join,proto3,oneof"`
+}
+
+type SessionResponse_NightAction struct {
+	NightAction *NightActionResponse `protobuf:"bytes,12,opt,name=night_action,json=nightAction,proto3,oneof"`
+}
+
+type SessionResponse_Vote struct {
+	Vote *VoteResponse `protobuf:"bytes,13,opt,name=vote,proto3,oneof"`
+}
+
+type SessionResponse_Chat struct {
+	Chat *SendChatMessageResponse `protobuf:"bytes,14,opt,name=chat,proto3,oneof"`
+}
+
+type SessionResponse_Error struct {
+	Error *SessionError `protobuf:"bytes,15,opt,name=error,proto3,oneof"`
+}
+
+type SessionResponse_Ready struct {
+	Ready *SessionActionResult `protobuf:"bytes,16,opt,name=ready,proto3,oneof"`
+}
+
+type SessionResponse_EndSpeech struct {
+	EndSpeech *SessionActionResult `protobuf:"bytes,17,opt,name=end_speech,json=endSpeech,proto3,oneof"`
+}
+
+func (*SessionResponse_Event) isSessionResponse_Response() {}
+
+func (*SessionResponse_Join) isSessionResponse_Response() {}
+
+func (*SessionResponse_NightAction) isSessionResponse_Response() {}
+
+func (*SessionResponse_Vote) isSessionResponse_Response() {}
+
+func (*SessionResponse_Chat) isSessionResponse_Response() {}
+
+func (*SessionResponse_Error) isSessionResponse_Response() {}
+
+func (*SessionResponse_Ready) isSessionResponse_Response() {}
+
+func (*SessionResponse_EndSpeech) isSessionResponse_Response() {}
+
+// 房间列表请求
+type ListRoomsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeFinished bool                   `protobuf:"varint,1,opt,name=include_finished,json=includeFinished,proto3" json:"include_finished,omitempty"` // 是否包含已结束的房间
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListRoomsRequest) Reset() {
+	*x = ListRoomsRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsRequest) ProtoMessage() {}
+
+func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListRoomsRequest) GetIncludeFinished() bool {
+	if x != nil {
+		return x.IncludeFinished
+	}
+	return false
+}
+
+// 大厅中的房间摘要
+type RoomSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RoomId         string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName       string                 `protobuf:"bytes,2,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	State          GameState              `protobuf:"varint,3,opt,name=state,proto3,enum=werewolf.v1.GameState" json:"state,omitempty"`
+	PlayerCount    int32                  `protobuf:"varint,4,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	MaxPlayers     int32                  `protobuf:"varint,5,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	SpectatorCount int32                  `protobuf:"varint,6,opt,name=spectator_count,json=spectatorCount,proto3" json:"spectator_count,omitempty"` // 当前在线的观战者数量
+	DayCount       int32                  `protobuf:"varint,7,opt,name=day_count,json=dayCount,proto3" json:"day_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RoomSummary) Reset() {
+	*x = RoomSummary{}
+	mi := &file_v1_werewolf_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomSummary) ProtoMessage() {}
+
+func (x *RoomSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomSummary.ProtoReflect.Descriptor instead.
+func (*RoomSummary) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RoomSummary) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomSummary) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *RoomSummary) GetState() GameState {
+	if x != nil {
+		return x.State
+	}
+	return GameState_WAITING
+}
+
+func (x *RoomSummary) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *RoomSummary) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *RoomSummary) GetSpectatorCount() int32 {
+	if x != nil {
+		return x.SpectatorCount
+	}
+	return 0
+}
+
+func (x *RoomSummary) GetDayCount() int32 {
+	if x != nil {
+		return x.DayCount
+	}
+	return 0
+}
+
+type ListRoomsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*RoomSummary         `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomsResponse) Reset() {
+	*x = ListRoomsResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsResponse) ProtoMessage() {}
+
+func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListRoomsResponse) GetRooms() []*RoomSummary {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+// 获取复盘报告请求
+type GetGameReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameReportRequest) Reset() {
+	*x = GetGameReportRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameReportRequest) ProtoMessage() {}
+
+func (x *GetGameReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameReportRequest.ProtoReflect.Descriptor instead.
+func (*GetGameReportRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetGameReportRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type GetGameReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *GameReport            `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameReportResponse) Reset() {
+	*x = GetGameReportResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameReportResponse) ProtoMessage() {}
+
+func (x *GetGameReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameReportResponse.ProtoReflect.Descriptor instead.
+func (*GetGameReportResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetGameReportResponse) GetReport() *GameReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// 匹配请求，流保持打开期间玩家留在队列中，关闭流即退出队列
+type JoinQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Board         string                 `protobuf:"bytes,3,opt,name=board,proto3" json:"board,omitempty"` // 预设板子，如 standard_6
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinQueueRequest) Reset() {
+	*x = JoinQueueRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinQueueRequest) ProtoMessage() {}
+
+func (x *JoinQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinQueueRequest.ProtoReflect.Descriptor instead.
+func (*JoinQueueRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *JoinQueueRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *JoinQueueRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *JoinQueueRequest) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+// 匹配状态推送
+type QueueUpdate struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Status               QueueUpdate_Status     `protobuf:"varint,1,opt,name=status,proto3,enum=werewolf.v1.QueueUpdate_Status" json:"status,omitempty"`
+	Board                string                 `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Position             int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"` // 在队列中的位置，从 1 开始
+	QueueSize            int32                  `protobuf:"varint,4,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	EstimatedWaitSeconds int32                  `protobuf:"varint,5,opt,name=estimated_wait_seconds,json=estimatedWaitSeconds,proto3" json:"estimated_wait_seconds,omitempty"` // 预计还需等待的时间
+	Rating               int32                  `protobuf:"varint,6,opt,name=rating,proto3" json:"rating,omitempty"`                                                           // 玩家当前等级分
+	RoomId               string                 `protobuf:"bytes,7,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QueueUpdate) Reset() {
+	*x = QueueUpdate{}
+	mi := &file_v1_werewolf_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueUpdate) ProtoMessage() {}
+
+func (x *QueueUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueUpdate.ProtoReflect.Descriptor instead.
+func (*QueueUpdate) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *QueueUpdate) GetStatus() QueueUpdate_Status {
+	if x != nil {
+		return x.Status
+	}
+	return QueueUpdate_STATUS_UNKNOWN
+}
+
+func (x *QueueUpdate) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *QueueUpdate) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *QueueUpdate) GetQueueSize() int32 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+func (x *QueueUpdate) GetEstimatedWaitSeconds() int32 {
+	if x != nil {
+		return x.EstimatedWaitSeconds
+	}
+	return 0
+}
+
+func (x *QueueUpdate) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *QueueUpdate) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+// 网关订阅整个房间的事件，只允许网关调用
+type SubscribeRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	LastSeq       int64                  `protobuf:"varint,2,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"` // 重新订阅时先补发序号大于该值的事件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRoomRequest) Reset() {
+	*x = SubscribeRoomRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRoomRequest) ProtoMessage() {}
+
+func (x *SubscribeRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRoomRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRoomRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SubscribeRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SubscribeRoomRequest) GetLastSeq() int64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+// 房间订阅中的一次投递，事件已按接收者投影，由网关分发给本地连接
+// 上帝视角观战者需要补发延迟事件，不通过房间订阅接收
+type RoomEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *GameEvent             `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	PlayerIds     []string               `protobuf:"bytes,2,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"` // 可以收到该事件的座位玩家
+	Spectators    bool                   `protobuf:"varint,3,opt,name=spectators,proto3" json:"spectators,omitempty"`               // 普通观战者可以收到
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomEvent) Reset() {
+	*x = RoomEvent{}
+	mi := &file_v1_werewolf_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomEvent) ProtoMessage() {}
+
+func (x *RoomEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomEvent.ProtoReflect.Descriptor instead.
+func (*RoomEvent) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RoomEvent) GetEvent() *GameEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetSpectators() bool {
+	if x != nil {
+		return x.Spectators
+	}
+	return false
+}
+
+// 观战者离开房间
+type StopSpectatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopSpectatingRequest) Reset() {
+	*x = StopSpectatingRequest{}
+	mi := &file_v1_werewolf_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopSpectatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopSpectatingRequest) ProtoMessage() {}
+
+func (x *StopSpectatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopSpectatingRequest.ProtoReflect.Descriptor instead.
+func (*StopSpectatingRequest) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *StopSpectatingRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *StopSpectatingRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type StopSpectatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reason        ErrorReason            `protobuf:"varint,3,opt,name=reason,proto3,enum=werewolf.v1.ErrorReason" json:"reason,omitempty"` // success 为 false 时的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopSpectatingResponse) Reset() {
+	*x = StopSpectatingResponse{}
+	mi := &file_v1_werewolf_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopSpectatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopSpectatingResponse) ProtoMessage() {}
+
+func (x *StopSpectatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopSpectatingResponse.ProtoReflect.Descriptor instead.
+func (*StopSpectatingResponse) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *StopSpectatingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StopSpectatingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StopSpectatingResponse) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+// 网关 WebSocket 的应用层心跳
+type GatewayPing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayPing) Reset() {
+	*x = GatewayPing{}
+	mi := &file_v1_werewolf_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayPing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayPing) ProtoMessage() {}
+
+func (x *GatewayPing) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayPing.ProtoReflect.Descriptor instead.
+func (*GatewayPing) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GatewayPing) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GatewayPong struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayPong) Reset() {
+	*x = GatewayPong{}
+	mi := &file_v1_werewolf_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayPong) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayPong) ProtoMessage() {}
+
+func (x *GatewayPong) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayPong.ProtoReflect.Descriptor instead.
+func (*GatewayPong) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GatewayPong) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GatewayPong) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// 网关 WebSocket 的客户端帧，客户端协商 werewolf.v1.json（protojson，文本帧）或 werewolf.v1.proto（二进制帧）子协议时使用
+// request 只支持夜间行动、投票、聊天、准备和结束发言，房间和玩家由连接确定
+type ClientFrame struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Frame:
+	//
+	//	*ClientFrame_Request
+	//	*ClientFrame_Ping
+	Frame         isClientFrame_Frame `protobuf_oneof:"frame"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientFrame) Reset() {
+	*x = ClientFrame{}
+	mi := &file_v1_werewolf_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientFrame) ProtoMessage() {}
+
+func (x *ClientFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientFrame.ProtoReflect.Descriptor instead.
+func (*ClientFrame) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ClientFrame) GetFrame() isClientFrame_Frame {
+	if x != nil {
+		return x.Frame
+	}
+	return nil
+}
+
+func (x *ClientFrame) GetRequest() *SessionRequest {
+	if x != nil {
+		if x, ok := x.Frame.(*ClientFrame_Request); ok {
+			return x.Request
+		}
+	}
+	return nil
+}
+
+func (x *ClientFrame) GetPing() *GatewayPing {
+	if x != nil {
+		if x, ok := x.Frame.(*ClientFrame_Ping); ok {
+			return x.Ping
+		}
+	}
+	return nil
+}
+
+type isClientFrame_Frame interface {
+	isClientFrame_Frame()
+}
+
+type ClientFrame_Request struct {
+	Request *SessionRequest `protobuf:"bytes,1,opt,name=request,proto3,oneof"`
+}
+
+type ClientFrame_Ping struct {
+	Ping *GatewayPing `protobuf:"bytes,2,opt,name=ping,proto3,oneof"`
+}
+
+func (*ClientFrame_Request) isClientFrame_Frame() {}
+
+func (*ClientFrame_Ping) isClientFrame_Frame() {}
+
+// 网关 WebSocket 的服务端帧，请求结果、错误和游戏事件与会话响应相同
+// 未通过游戏规则校验的请求返回 success 为 false 的结果；网关自身的错误以 SessionError 返回，code 如 invalid_message
+type ServerFrame struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Frame:
+	//
+	//	*ServerFrame_Session
+	//	*ServerFrame_QueueUpdate
+	//	*ServerFrame_Pong
+	Frame         isServerFrame_Frame `protobuf_oneof:"frame"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerFrame) Reset() {
+	*x = ServerFrame{}
+	mi := &file_v1_werewolf_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerFrame) ProtoMessage() {}
+
+func (x *ServerFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_werewolf_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerFrame.ProtoReflect.Descriptor instead.
+func (*ServerFrame) Descriptor() ([]byte, []int) {
+	return file_v1_werewolf_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ServerFrame) GetFrame() isServerFrame_Frame {
+	if x != nil {
+		return x.Frame
+	}
+	return nil
+}
+
+func (x *ServerFrame) GetSession() *SessionResponse {
+	if x != nil {
+		if x, ok := x.Frame.(*ServerFrame_Session); ok {
+			return x.Session
+		}
+	}
+	return nil
+}
+
+func (x *ServerFrame) GetQueueUpdate() *QueueUpdate {
+	if x != nil {
+		if x, ok := x.Frame.(*ServerFrame_QueueUpdate); ok {
+			return x.QueueUpdate
+		}
+	}
+	return nil
+}
+
+func (x *ServerFrame) GetPong() *GatewayPong {
+	if x != nil {
+		if x, ok := x.Frame.(*ServerFrame_Pong); ok {
+			return x.Pong
+		}
+	}
+	return nil
+}
+
+type isServerFrame_Frame interface {
+	isServerFrame_Frame()
+}
+
+type ServerFrame_Session struct {
+	Session *SessionResponse `protobuf:"bytes,1,opt,name=session,proto3,oneof"`
+}
+
+type ServerFrame_QueueUpdate struct {
+	QueueUpdate *QueueUpdate `protobuf:"bytes,2,opt,name=queue_update,json=queueUpdate,proto3,oneof"`
+}
+
+type ServerFrame_Pong struct {
+	Pong *GatewayPong `protobuf:"bytes,3,opt,name=pong,proto3,oneof"`
+}
+
+func (*ServerFrame_Session) isServerFrame_Frame() {}
+
+func (*ServerFrame_QueueUpdate) isServerFrame_Frame() {}
+
+func (*ServerFrame_Pong) isServerFrame_Frame() {}
+
+var File_v1_werewolf_proto protoreflect.FileDescriptor
+
+const file_v1_werewolf_proto_rawDesc = "" +
+	"\n" +
+	"\x11v1/werewolf.proto\x12\vwerewolf.v1\"?\n" +
+	"\vErrorDetail\x120\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x18.werewolf.v1.ErrorReasonR\x06reason\"\x81\x03\n" +
+	"\x06Player\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x11.werewolf.v1.RoleR\x04role\x12%\n" +
+	"\x04camp\x18\x04 \x01(\x0e2\x11.werewolf.v1.CampR\x04camp\x12\x19\n" +
+	"\bis_alive\x18\x05 \x01(\bR\aisAlive\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12\x17\n" +
+	"\acan_act\x18\a \x01(\bR\x06canAct\x12\x15\n" +
+	"\x06is_afk\x18\b \x01(\bR\x05isAfk\x12%\n" +
+	"\x0ebot_controlled\x18\t \x01(\bR\rbotControlled\x12\x14\n" +
+	"\x05ready\x18\n" +
+	" \x01(\bR\x05ready\x127\n" +
+	"\bpresence\x18\v \x01(\x0e2\x1b.werewolf.v1.PresenceStatusR\bpresence\x12\x1b\n" +
+	"\tlast_seen\x18\f \x01(\x03R\blastSeen\"\xe2\x01\n" +
+	"\vNightAction\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12%\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x11.werewolf.v1.RoleR\x04role\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12#\n" +
+	"\vaction_type\x18\x04 \x01(\tB\x02\x18\x01R\n" +
+	"actionType\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\x12/\n" +
+	"\x06action\x18\x06 \x01(\x0e2\x17.werewolf.v1.ActionTypeR\x06action\"\xc7\x01\n" +
+	"\tPhaseInfo\x127\n" +
+	"\rcurrent_phase\x18\x01 \x01(\x0e2\x12.werewolf.v1.PhaseR\fcurrentPhase\x12\x1d\n" +
+	"\n" +
+	"phase_name\x18\x02 \x01(\tR\tphaseName\x12!\n" +
+	"\factive_roles\x18\x03 \x03(\tR\vactiveRoles\x12\x1d\n" +
+	"\n" +
+	"time_limit\x18\x04 \x01(\x05R\ttimeLimit\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xf8\x01\n" +
+	"\x0fAvailableAction\x12#\n" +
+	"\vaction_type\x18\x01 \x01(\tB\x02\x18\x01R\n" +
+	"actionType\x12\x1d\n" +
+	"\n" +
+	"target_ids\x18\x02 \x03(\tR\ttargetIds\x12'\n" +
+	"\x0frequires_target\x18\x03 \x01(\bR\x0erequiresTarget\x12%\n" +
+	"\x0eremaining_uses\x18\x04 \x01(\x05R\rremainingUses\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12/\n" +
+	"\x06action\x18\x06 \x01(\x0e2\x17.werewolf.v1.ActionTypeR\x06action\"|\n" +
+	"\vWitchPrompt\x12\x1b\n" +
+	"\tvictim_id\x18\x01 \x01(\tR\bvictimId\x12%\n" +
+	"\x0esave_available\x18\x02 \x01(\bR\rsaveAvailable\x12)\n" +
+	"\x10poison_available\x18\x03 \x01(\bR\x0fpoisonAvailable\"b\n" +
+	"\n" +
+	"SeerResult\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12%\n" +
+	"\x04camp\x18\x02 \x01(\x0e2\x11.werewolf.v1.CampR\x04camp\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\"s\n" +
+	"\x11WitchPotionRecord\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12/\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x17.werewolf.v1.ActionTypeR\x06action\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\"<\n" +
+	"\vGuardRecord\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\"\xeb\x01\n" +
+	"\x10PrivateKnowledge\x128\n" +
+	"\vseer_checks\x18\x01 \x03(\v2\x17.werewolf.v1.SeerResultR\n" +
+	"seerChecks\x12C\n" +
+	"\rwitch_potions\x18\x02 \x03(\v2\x1e.werewolf.v1.WitchPotionRecordR\fwitchPotions\x12=\n" +
+	"\rguard_records\x18\x03 \x03(\v2\x18.werewolf.v1.GuardRecordR\fguardRecords\x12\x19\n" +
+	"\blover_id\x18\x04 \x01(\tR\aloverId\"\x8a\x02\n" +
+	"\tVoteTally\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12:\n" +
+	"\x06counts\x18\x02 \x03(\v2\".werewolf.v1.VoteTally.CountsEntryR\x06counts\x12#\n" +
+	"\reliminated_id\x18\x03 \x01(\tR\feliminatedId\x12-\n" +
+	"\aballots\x18\x04 \x03(\v2\x13.werewolf.v1.BallotR\aballots\x12 \n" +
+	"\vabstentions\x18\x05 \x01(\x05R\vabstentions\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xb7\x01\n" +
+	"\vChatMessage\x12\x1b\n" +
+	"\tsender_id\x18\x01 \x01(\tR\bsenderId\x12\x1f\n" +
+	"\vsender_name\x18\x02 \x01(\tR\n" +
+	"senderName\x122\n" +
+	"\achannel\x18\x03 \x01(\x0e2\x18.werewolf.v1.ChatChannelR\achannel\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\"H\n" +
+	"\vSpeakerTurn\x12\x1d\n" +
+	"\n" +
+	"speaker_id\x18\x01 \x01(\tR\tspeakerId\x12\x1a\n" +
+	"\bdeadline\x18\x02 \x01(\x03R\bdeadline\"Z\n" +
+	"\x06Ballot\x12\x19\n" +
+	"\bvoter_id\x18\x01 \x01(\tR\avoterId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x18\n" +
+	"\aabstain\x18\x03 \x01(\bR\aabstain\">\n" +
+	"\vDeathReport\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12\x1d\n" +
+	"\n" +
+	"player_ids\x18\x02 \x03(\tR\tplayerIds\"j\n" +
+	"\fGameOverInfo\x12)\n" +
+	"\x06winner\x18\x01 \x01(\x0e2\x11.werewolf.v1.CampR\x06winner\x12/\n" +
+	"\x06report\x18\x02 \x01(\v2\x17.werewolf.v1.GameReportR\x06report\"\x92\x01\n" +
+	"\fPlayerReveal\x12+\n" +
+	"\x06player\x18\x01 \x01(\v2\x13.werewolf.v1.PlayerR\x06player\x128\n" +
+	"\vdeath_cause\x18\x02 \x01(\x0e2\x17.werewolf.v1.DeathCauseR\n" +
+	"deathCause\x12\x1b\n" +
+	"\tdeath_day\x18\x03 \x01(\x05R\bdeathDay\"\xae\x02\n" +
+	"\rTimelineEntry\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12(\n" +
+	"\x05phase\x18\x02 \x01(\x0e2\x12.werewolf.v1.PhaseR\x05phase\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12/\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x17.werewolf.v1.ActionTypeR\x06action\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x128\n" +
+	"\vdeath_cause\x18\x06 \x01(\x0e2\x17.werewolf.v1.DeathCauseR\n" +
+	"deathCause\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\x03R\ttimestamp\"\xe5\x02\n" +
+	"\n" +
+	"GameReport\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\troom_name\x18\x02 \x01(\tR\broomName\x12)\n" +
+	"\x06winner\x18\x03 \x01(\x0e2\x11.werewolf.v1.CampR\x06winner\x12\x1b\n" +
+	"\tday_count\x18\x04 \x01(\x05R\bdayCount\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\x03R\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x06 \x01(\x03R\n" +
+	"finishedAt\x123\n" +
+	"\aplayers\x18\a \x03(\v2\x19.werewolf.v1.PlayerRevealR\aplayers\x126\n" +
+	"\btimeline\x18\b \x03(\v2\x1a.werewolf.v1.TimelineEntryR\btimeline\x12,\n" +
+	"\x05votes\x18\t \x03(\v2\x16.werewolf.v1.VoteTallyR\x05votes\"\x9a\x01\n" +
+	"\x10VisibilityConfig\x12\"\n" +
+	"\rdead_god_view\x18\x01 \x01(\bR\vdeadGodView\x12,\n" +
+	"\x12spectator_god_view\x18\x02 \x01(\bR\x10spectatorGodView\x124\n" +
+	"\x16spectator_delay_phases\x18\x03 \x01(\x05R\x14spectatorDelayPhases\"\xe3\x01\n" +
+	"\rTimeoutPolicy\x120\n" +
+	"\x14werewolf_random_kill\x18\x01 \x01(\bR\x12werewolfRandomKill\x12#\n" +
+	"\rafk_threshold\x18\x02 \x01(\x05R\fafkThreshold\x12!\n" +
+	"\fbot_takeover\x18\x03 \x01(\bR\vbotTakeover\x12,\n" +
+	"\x12vote_random_target\x18\x04 \x01(\bR\x10voteRandomTarget\x12*\n" +
+	"\x11seer_random_check\x18\x05 \x01(\bR\x0fseerRandomCheck\"\x84\x04\n" +
+	"\x11CreateRoomRequest\x12\x1b\n" +
+	"\troom_name\x18\x01 \x01(\tR\broomName\x12\x1f\n" +
+	"\vmax_players\x18\x02 \x01(\x05R\n" +
+	"maxPlayers\x12O\n" +
+	"\vrole_config\x18\x03 \x03(\v2..werewolf.v1.CreateRoomRequest.RoleConfigEntryR\n" +
+	"roleConfig\x12=\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\v2\x1d.werewolf.v1.VisibilityConfigR\n" +
+	"visibility\x12A\n" +
+	"\x0etimeout_policy\x18\x05 \x01(\v2\x1a.werewolf.v1.TimeoutPolicyR\rtimeoutPolicy\x122\n" +
+	"\tvote_mode\x18\x06 \x01(\x0e2\x15.werewolf.v1.VoteModeR\bvoteMode\x12'\n" +
+	"\x0fstrict_speaking\x18\a \x01(\bR\x0estrictSpeaking\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\b \x01(\tR\tcreatorId\x12#\n" +
+	"\rrandom_lovers\x18\t \x01(\bR\frandomLovers\x1a=\n" +
+	"\x0fRoleConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"G\n" +
+	"\x12CreateRoomResponse\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x9f\x01\n" +
+	"\x0fJoinRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x03 \x01(\tR\n" +
+	"playerName\x12\x1a\n" +
+	"\bspectate\x18\x04 \x01(\bR\bspectate\x12\x19\n" +
+	"\bgod_view\x18\x05 \x01(\bR\agodView\"\xa5\x01\n" +
+	"\x10JoinRoomResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x06player\x18\x03 \x01(\v2\x13.werewolf.v1.PlayerR\x06player\x120\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x18.werewolf.v1.ErrorReasonR\x06reason\"H\n" +
+	"\x10StartGameRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"\xb0\x01\n" +
+	"\x11StartGameResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\n" +
+	"phase_info\x18\x03 \x01(\v2\x16.werewolf.v1.PhaseInfoR\tphaseInfo\x120\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x18.werewolf.v1.ErrorReasonR\x06reason\"\xca\x01\n" +
+	"\x12NightActionRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12(\n" +
+	"\x10target_player_id\x18\x03 \x01(\tR\x0etargetPlayerId\x12#\n" +
+	"\vaction_type\x18\x04 \x01(\tB\x02\x18\x01R\n" +
+	"actionType\x12/\n" +
+	"\x06action\x18\x05 \x01(\x0e2\x17.werewolf.v1.ActionTypeR\x06action\"\xcd\x01\n" +
+	"\x13NightActionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\tR\x06result\x128\n" +
+	"\vseer_result\x18\x04 \x01(\v2\x17.werewolf.v1.SeerResultR\n" +
+	"seerResult\x120\n" +
+	"\x06reason\x18\x05 \x01(\x0e2\x18.werewolf.v1.ErrorReasonR\x06reason\"x\n" +
+	"\vVoteRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x19\n" +
+	"\bvoter_id\x18\x02 \x01(\tR\avoterId\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x18\n" +
+	"\aabstain\x18\x04 \x01(\bR\aabstain\"t\n" +
+	"\fVoteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x18.werewolf.v1.ErrorReasonR\x06reason\"\x84\x01\n" +
+	"\x13GetGameStateRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x1c\n" +
+	"\tspectator\x18\x03 \x01(\bR\tspectator\x12\x19\n" +
+	"\blast_seq\x18\x04 \x01(\x03R\alastSeq\"\x94\x03\n" +
+	"\x14GetGameStateResponse\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12,\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x16.werewolf.v1.GameStateR\x05state\x125\n" +
+	"\n" +
+	"phase_info\x18\x03 \x01(\v2\x16.werewolf.v1.PhaseInfoR\tphaseInfo\x12-\n" +
+	"\aplayers\x18\x04 \x03(\v2\x13.werewolf.v1.PlayerR\aplayers\x12\x1b\n" +
+	"\tday_count\x18\x05 \x01(\x05R\bdayCount\x12:\n" +
+	"\x0ecurrent_player\x18\x06 \x01(\v2\x13.werewolf.v1.PlayerR\rcurrentPlayer\x12;\n" +
+	"\tknowledge\x18\a \x01(\v2\x1d.werewolf.v1.PrivateKnowledgeR\tknowledge\x129\n" +
+	"\fvote_history\x18\b \x03(\v2\x16.werewolf.v1.VoteTallyR\vvoteHistory\"R\n" +
+	"\x1aGetAvailableActionsRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"\xd1\x01\n" +
+	"\x1bGetAvailableActionsResponse\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12(\n" +
+	"\x05phase\x18\x03 \x01(\x0e2\x12.werewolf.v1.PhaseR\x05phase\x126\n" +
+	"\aactions\x18\x04 \x03(\v2\x1c.werewolf.v1.AvailableActionR\aactions\x12\x1a\n" +
+	"\bdeadline\x18\x05 \x01(\x03R\bdeadline\"\x92\v\n" +
+	"\tGameEvent\x12?\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\x0e2 .werewolf.v1.GameEvent.EventTypeR\teventType\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\n" +
+	"phase_info\x18\x03 \x01(\v2\x16.werewolf.v1.PhaseInfoR\tphaseInfo\x12>\n" +
+	"\x10affected_players\x18\x04 \x03(\v2\x13.werewolf.v1.PlayerR\x0faffectedPlayers\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\x12H\n" +
+	"\n" +
+	"extra_data\x18\x06 \x03(\v2%.werewolf.v1.GameEvent.ExtraDataEntryB\x02\x18\x01R\textraData\x12I\n" +
+	"\x11available_actions\x18\a \x03(\v2\x1c.werewolf.v1.AvailableActionR\x10availableActions\x12\x1a\n" +
+	"\bdeadline\x18\b \x01(\x03R\bdeadline\x12\x10\n" +
+	"\x03seq\x18\t \x01(\x03R\x03seq\x12=\n" +
+	"\fwitch_prompt\x18\n" +
+	" \x01(\v2\x18.werewolf.v1.WitchPromptH\x00R\vwitchPrompt\x12:\n" +
+	"\vseer_result\x18\v \x01(\v2\x17.werewolf.v1.SeerResultH\x00R\n" +
+	"seerResult\x127\n" +
+	"\n" +
+	"vote_tally\x18\f \x01(\v2\x16.werewolf.v1.VoteTallyH\x00R\tvoteTally\x12=\n" +
+	"\fdeath_report\x18\r \x01(\v2\x18.werewolf.v1.DeathReportH\x00R\vdeathReport\x128\n" +
+	"\tgame_over\x18\x0e \x01(\v2\x19.werewolf.v1.GameOverInfoH\x00R\bgameOver\x12C\n" +
+	"\fwitch_potion\x18\x0f \x01(\v2\x1e.werewolf.v1.WitchPotionRecordH\x00R\vwitchPotion\x12=\n" +
+	"\fguard_record\x18\x10 \x01(\v2\x18.werewolf.v1.GuardRecordH\x00R\vguardRecord\x12.\n" +
+	"\x04chat\x18\x11 \x01(\v2\x18.werewolf.v1.ChatMessageH\x00R\x04chat\x12=\n" +
+	"\fspeaker_turn\x18\x12 \x01(\v2\x18.werewolf.v1.SpeakerTurnH\x00R\vspeakerTurn\x1a<\n" +
+	"\x0eExtraDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe8\x02\n" +
+	"\tEventType\x12\x11\n" +
+	"\rEVENT_UNKNOWN\x10\x00\x12\x17\n" +
+	"\x13EVENT_PLAYER_JOINED\x10\x01\x12\x16\n" +
+	"\x12EVENT_GAME_STARTED\x10\x02\x12\x17\n" +
+	"\x13EVENT_PHASE_CHANGED\x10\x03\x12\x15\n" +
+	"\x11EVENT_PLAYER_DIED\x10\x04\x12\x1a\n" +
+	"\x16EVENT_ACTION_COMPLETED\x10\x05\x12\x13\n" +
+	"\x0fEVENT_GAME_OVER\x10\x06\x12\x13\n" +
+	"\x0fEVENT_YOUR_TURN\x10\a\x12\x15\n" +
+	"\x11EVENT_VOTE_RESULT\x10\b\x12\x16\n" +
+	"\x12EVENT_NIGHT_RESULT\x10\t\x12\x14\n" +
+	"\x10EVENT_PLAYER_AFK\x10\n" +
+	"\x12\x0e\n" +
+	"\n" +
+	"EVENT_CHAT\x10\v\x12\x19\n" +
+	"\x15EVENT_SPEAKER_CHANGED\x10\f\x12\x16\n" +
+	"\x12EVENT_PLAYER_READY\x10\r\x12\x19\n" +
+	"\x15EVENT_PLAYER_PRESENCE\x10\x0eB\t\n" +
+	"\apayload\"\x9c\x01\n" +
+	"\x16SendChatMessageRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x122\n" +
+	"\achannel\x18\x03 \x01(\x0e2\x18.werewolf.v1.ChatChannelR\achannel\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"\x7f\n" +
+	"\x17SendChatMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x18.werewolf.v1.ErrorReasonR\x06reason\"\xd7\x01\n" +
+	"\vSessionJoin\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x03 \x01(\tR\n" +
+	"playerName\x12\x19\n" +
+	"\blast_seq\x18\x04 \x01(\x03R\alastSeq\x12\x1a\n" +
+	"\bspectate\x18\x05 \x01(\bR\bspectate\x12\x19\n" +
+	"\bgod_view\x18\x06 \x01(\bR\agodView\x12\x1f\n" +
+	"\vskip_events\x18\a \x01(\bR\n" +
+	"skipEvents\"\x1e\n" +
+	"\n" +
+	"SessionAck\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\"$\n" +
+	"\fSessionReady\x12\x14\n" +
+	"\x05ready\x18\x01 \x01(\bR\x05ready\"\x12\n" +
+	"\x10SessionEndSpeech\"{\n" +
+	"\x13SessionActionResult\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x18.werewolf.v1.ErrorReasonR\x06reason\"n\n" +
+	"\fSessionError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x18.werewolf.v1.ErrorReasonR\x06reason\"\xbb\x03\n" +
+	"\x0eSessionRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12.\n" +
+	"\x04join\x18\n" +
+	" \x01(\v2\x18.werewolf.v1.SessionJoinH\x00R\x04join\x12D\n" +
+	"\fnight_action\x18\v \x01(\v2\x1f.werewolf.v1.NightActionRequestH\x00R\vnightAction\x12.\n" +
+	"\x04vote\x18\f \x01(\v2\x18.werewolf.v1.VoteRequestH\x00R\x04vote\x129\n" +
+	"\x04chat\x18\r \x01(\v2#.werewolf.v1.SendChatMessageRequestH\x00R\x04chat\x12+\n" +
+	"\x03ack\x18\x0e \x01(\v2\x17.werewolf.v1.SessionAckH\x00R\x03ack\x121\n" +
+	"\x05ready\x18\x0f \x01(\v2\x19.werewolf.v1.SessionReadyH\x00R\x05ready\x12>\n" +
+	"\n" +
+	"end_speech\x18\x10 \x01(\v2\x1d.werewolf.v1.SessionEndSpeechH\x00R\tendSpeechB\t\n" +
+	"\arequest\"\x85\x04\n" +
+	"\x0fSessionResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12.\n" +
+	"\x05event\x18\n" +
+	" \x01(\v2\x16.werewolf.v1.GameEventH\x00R\x05event\x123\n" +
+	"\x04join\x18\v \x01(\v2\x1d.werewolf.v1.JoinRoomResponseH\x00R\x04join\x12E\n" +
+	"\fnight_action\x18\f \x01(\v2 .werewolf.v1.NightActionResponseH\x00R\vnightAction\x12/\n" +
+	"\x04vote\x18\r \x01(\v2\x19.werewolf.v1.VoteResponseH\x00R\x04vote\x12:\n" +
+	"\x04chat\x18\x0e \x01(\v2$.werewolf.v1.SendChatMessageResponseH\x00R\x04chat\x121\n" +
+	"\x05error\x18\x0f \x01(\v2\x19.werewolf.v1.SessionErrorH\x00R\x05error\x128\n" +
+	"\x05ready\x18\x10 \x01(\v2 .werewolf.v1.SessionActionResultH\x00R\x05ready\x12A\n" +
+	"\n" +
+	"end_speech\x18\x11 \x01(\v2 .werewolf.v1.SessionActionResultH\x00R\tendSpeechB\n" +
+	"\n" +
+	"\bresponse\"=\n" +
+	"\x10ListRoomsRequest\x12)\n" +
+	"\x10include_finished\x18\x01 \x01(\bR\x0fincludeFinished\"\xfb\x01\n" +
+	"\vRoomSummary\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\troom_name\x18\x02 \x01(\tR\broomName\x12,\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x16.werewolf.v1.GameStateR\x05state\x12!\n" +
+	"\fplayer_count\x18\x04 \x01(\x05R\vplayerCount\x12\x1f\n" +
+	"\vmax_players\x18\x05 \x01(\x05R\n" +
+	"maxPlayers\x12'\n" +
+	"\x0fspectator_count\x18\x06 \x01(\x05R\x0espectatorCount\x12\x1b\n" +
+	"\tday_count\x18\a \x01(\x05R\bdayCount\"C\n" +
+	"\x11ListRoomsResponse\x12.\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x18.werewolf.v1.RoomSummaryR\x05rooms\"/\n" +
+	"\x14GetGameReportRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"H\n" +
+	"\x15GetGameReportResponse\x12/\n" +
+	"\x06report\x18\x01 \x01(\v2\x17.werewolf.v1.GameReportR\x06report\"f\n" +
+	"\x10JoinQueueRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x14\n" +
+	"\x05board\x18\x03 \x01(\tR\x05board\"\xc4\x02\n" +
+	"\vQueueUpdate\x127\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1f.werewolf.v1.QueueUpdate.StatusR\x06status\x12\x14\n" +
+	"\x05board\x18\x02 \x01(\tR\x05board\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x1d\n" +
+	"\n" +
+	"queue_size\x18\x04 \x01(\x05R\tqueueSize\x124\n" +
+	"\x16estimated_wait_seconds\x18\x05 \x01(\x05R\x14estimatedWaitSeconds\x12\x16\n" +
+	"\x06rating\x18\x06 \x01(\x05R\x06rating\x12\x17\n" +
+	"\aroom_id\x18\a \x01(\tR\x06roomId\"D\n" +
+	"\x06Status\x12\x12\n" +
+	"\x0eSTATUS_UNKNOWN\x10\x00\x12\x12\n" +
+	"\x0eSTATUS_WAITING\x10\x01\x12\x12\n" +
+	"\x0eSTATUS_MATCHED\x10\x02\"J\n" +
+	"\x14SubscribeRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x19\n" +
+	"\blast_seq\x18\x02 \x01(\x03R\alastSeq\"x\n" +
+	"\tRoomEvent\x12,\n" +
+	"\x05event\x18\x01 \x01(\v2\x16.werewolf.v1.GameEventR\x05event\x12\x1d\n" +
+	"\n" +
+	"player_ids\x18\x02 \x03(\tR\tplayerIds\x12\x1e\n" +
+	"\n" +
+	"spectators\x18\x03 \x01(\bR\n" +
+	"spectators\"M\n" +
+	"\x15StopSpectatingRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"~\n" +
+	"\x16StopSpectatingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x18.werewolf.v1.ErrorReasonR\x06reason\",\n" +
+	"\vGatewayPing\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"J\n" +
+	"\vGatewayPong\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\"\x7f\n" +
+	"\vClientFrame\x127\n" +
+	"\arequest\x18\x01 \x01(\v2\x1b.werewolf.v1.SessionRequestH\x00R\arequest\x12.\n" +
+	"\x04ping\x18\x02 \x01(\v2\x18.werewolf.v1.GatewayPingH\x00R\x04pingB\a\n" +
+	"\x05frame\"\xbf\x01\n" +
+	"\vServerFrame\x128\n" +
+	"\asession\x18\x01 \x01(\v2\x1c.werewolf.v1.SessionResponseH\x00R\asession\x12=\n" +
+	"\fqueue_update\x18\x02 \x01(\v2\x18.werewolf.v1.QueueUpdateH\x00R\vqueueUpdate\x12.\n" +
+	"\x04pong\x18\x03 \x01(\v2\x18.werewolf.v1.GatewayPongH\x00R\x04pongB\a\n" +
+	"\x05frame*\xd7\x01\n" +
+	"\x05Phase\x12\x11\n" +
+	"\rPHASE_WAITING\x10\x00\x12\x15\n" +
+	"\x11PHASE_NIGHT_GUARD\x10\x01\x12\x18\n" +
+	"\x14PHASE_NIGHT_WEREWOLF\x10\x02\x12\x15\n" +
+	"\x11PHASE_NIGHT_WITCH\x10\x03\x12\x14\n" +
+	"\x10PHASE_NIGHT_SEER\x10\x04\x12\x18\n" +
+	"\x14PHASE_DAY_DISCUSSION\x10\x05\x12\x14\n" +
+	"\x10PHASE_DAY_VOTING\x10\x06\x12\x18\n" +
+	"\x14PHASE_DAY_LAST_WORDS\x10\a\x12\x13\n" +
+	"\x0fPHASE_GAME_OVER\x10\b*:\n" +
+	"\tGameState\x12\v\n" +
+	"\aWAITING\x10\x00\x12\t\n" +
+	"\x05NIGHT\x10\x01\x12\a\n" +
+	"\x03DAY\x10\x02\x12\f\n" +
+	"\bFINISHED\x10\x03*[\n" +
+	"\x04Role\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\f\n" +
+	"\bWEREWOLF\x10\x01\x12\f\n" +
+	"\bVILLAGER\x10\x02\x12\b\n" +
+	"\x04SEER\x10\x03\x12\t\n" +
+	"\x05WITCH\x10\x04\x12\n" +
+	"\n" +
+	"\x06HUNTER\x10\x05\x12\t\n" +
+	"\x05GUARD\x10\x06*>\n" +
+	"\x04Camp\x12\x10\n" +
+	"\fCAMP_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rCAMP_WEREWOLF\x10\x01\x12\x11\n" +
+	"\rCAMP_VILLAGER\x10\x02*\xaf\x01\n" +
+	"\n" +
+	"ActionType\x12\x12\n" +
+	"\x0eACTION_UNKNOWN\x10\x00\x12\x10\n" +
+	"\fACTION_GUARD\x10\x01\x12\x0f\n" +
+	"\vACTION_KILL\x10\x02\x12\x0f\n" +
+	"\vACTION_SAVE\x10\x03\x12\x11\n" +
+	"\rACTION_POISON\x10\x04\x12\x10\n" +
+	"\fACTION_CHECK\x10\x05\x12\x0f\n" +
+	"\vACTION_SKIP\x10\x06\x12\x0f\n" +
+	"\vACTION_VOTE\x10\a\x12\x12\n" +
+	"\x0eACTION_ABSTAIN\x10\b*7\n" +
+	"\bVoteMode\x12\x12\n" +
+	"\x0eVOTE_MODE_OPEN\x10\x00\x12\x17\n" +
+	"\x13VOTE_MODE_ANONYMOUS\x10\x01*l\n" +
+	"\x0ePresenceStatus\x12\x14\n" +
+	"\x10PRESENCE_UNKNOWN\x10\x00\x12\x13\n" +
+	"\x0fPRESENCE_ONLINE\x10\x01\x12\x19\n" +
+	"\x15PRESENCE_RECONNECTING\x10\x02\x12\x14\n" +
+	"\x10PRESENCE_OFFLINE\x10\x03*\xbd\x03\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bERROR_REASON_ROOM_NOT_FOUND\x10\x01\x12!\n" +
+	"\x1dERROR_REASON_PLAYER_NOT_FOUND\x10\x02\x12\x1a\n" +
+	"\x16ERROR_REASON_ROOM_FULL\x10\x03\x12%\n" +
+	"!ERROR_REASON_GAME_ALREADY_STARTED\x10\x04\x12\x1c\n" +
+	"\x18ERROR_REASON_WRONG_PHASE\x10\x05\x12\x1e\n" +
+	"\x1aERROR_REASON_NOT_YOUR_TURN\x10\x06\x12\x1f\n" +
+	"\x1bERROR_REASON_INVALID_TARGET\x10\a\x12\x1e\n" +
+	"\x1aERROR_REASON_ALREADY_VOTED\x10\b\x12#\n" +
+	"\x1fERROR_REASON_ACTION_NOT_ALLOWED\x10\t\x12 \n" +
+	"\x1cERROR_REASON_INVALID_MESSAGE\x10\n" +
+	"\x12\x1d\n" +
+	"\x19ERROR_REASON_RATE_LIMITED\x10\v\x12$\n" +
+	" ERROR_REASON_INVALID_ROLE_CONFIG\x10\f*\x8e\x01\n" +
+	"\vChatChannel\x12\x18\n" +
+	"\x14CHAT_CHANNEL_UNKNOWN\x10\x00\x12\x17\n" +
+	"\x13CHAT_CHANNEL_PUBLIC\x10\x01\x12\x19\n" +
+	"\x15CHAT_CHANNEL_WEREWOLF\x10\x02\x12\x15\n" +
+	"\x11CHAT_CHANNEL_DEAD\x10\x03\x12\x1a\n" +
+	"\x16CHAT_CHANNEL_SPECTATOR\x10\x04*j\n" +
+	"\n" +
+	"DeathCause\x12\x14\n" +
+	"\x10DEATH_CAUSE_NONE\x10\x00\x12\x18\n" +
+	"\x14DEATH_CAUSE_WEREWOLF\x10\x01\x12\x16\n" +
+	"\x12DEATH_CAUSE_POISON\x10\x02\x12\x14\n" +
+	"\x10DEATH_CAUSE_VOTE\x10\x032\xd7\t\n" +
+	"\x0fWerewolfService\x12M\n" +
+	"\n" +
+	"CreateRoom\x12\x1e.werewolf.v1.CreateRoomRequest\x1a\x1f.werewolf.v1.CreateRoomResponse\x12G\n" +
+	"\bJoinRoom\x12\x1c.werewolf.v1.JoinRoomRequest\x1a\x1d.werewolf.v1.JoinRoomResponse\x12J\n" +
+	"\tListRooms\x12\x1d.werewolf.v1.ListRoomsRequest\x1a\x1e.werewolf.v1.ListRoomsResponse\x12J\n" +
+	"\tStartGame\x12\x1d.werewolf.v1.StartGameRequest\x1a\x1e.werewolf.v1.StartGameResponse\x12P\n" +
+	"\vNightAction\x12\x1f.werewolf.v1.NightActionRequest\x1a .werewolf.v1.NightActionResponse\x12;\n" +
+	"\x04Vote\x12\x18.werewolf.v1.VoteRequest\x1a\x19.werewolf.v1.VoteResponse\x12S\n" +
+	"\fGetGameState\x12 .werewolf.v1.GetGameStateRequest\x1a!.werewolf.v1.GetGameStateResponse\x12h\n" +
+	"\x13GetAvailableActions\x12'.werewolf.v1.GetAvailableActionsRequest\x1a(.werewolf.v1.GetAvailableActionsResponse\x12V\n" +
+	"\rGetGameReport\x12!.werewolf.v1.GetGameReportRequest\x1a\".werewolf.v1.GetGameReportResponse\x12Q\n" +
+	"\x13SubscribeGameEvents\x12 .werewolf.v1.GetGameStateRequest\x1a\x16.werewolf.v1.GameEvent0\x01\x12\\\n" +
+	"\x0fSendChatMessage\x12#.werewolf.v1.SendChatMessageRequest\x1a$.werewolf.v1.SendChatMessageResponse\x12L\n" +
+	"\vGameSession\x12\x1b.werewolf.v1.SessionRequest\x1a\x1c.werewolf.v1.SessionResponse(\x010\x01\x12F\n" +
+	"\tJoinQueue\x12\x1d.werewolf.v1.JoinQueueRequest\x1a\x18.werewolf.v1.QueueUpdate0\x01\x12L\n" +
+	"\rSubscribeRoom\x12!.werewolf.v1.SubscribeRoomRequest\x1a\x16.werewolf.v1.RoomEvent0\x01\x12Y\n" +
+	"\x0eStopSpectating\x12\".werewolf.v1.StopSpectatingRequest\x1a#.werewolf.v1.StopSpectatingResponseB!Z\x1fliam/pkg/werewolf/v1;werewolfv1b\x06proto3"
+
+var (
+	file_v1_werewolf_proto_rawDescOnce sync.Once
+	file_v1_werewolf_proto_rawDescData []byte
+)
+
+func file_v1_werewolf_proto_rawDescGZIP() []byte {
+	file_v1_werewolf_proto_rawDescOnce.Do(func() {
+		file_v1_werewolf_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_werewolf_proto_rawDesc), len(file_v1_werewolf_proto_rawDesc)))
+	})
+	return file_v1_werewolf_proto_rawDescData
+}
+
+var file_v1_werewolf_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_v1_werewolf_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
+var file_v1_werewolf_proto_goTypes = []any{
+	(Phase)(0),                          // 0: werewolf.v1.Phase
+	(GameState)(0),                      // 1: werewolf.v1.GameState
+	(Role)(0),                           // 2: werewolf.v1.Role
+	(Camp)(0),                           // 3: werewolf.v1.Camp
+	(ActionType)(0),                     // 4: werewolf.v1.ActionType
+	(VoteMode)(0),                       // 5: werewolf.v1.VoteMode
+	(PresenceStatus)(0),                 // 6: werewolf.v1.PresenceStatus
+	(ErrorReason)(0),                    // 7: werewolf.v1.ErrorReason
+	(ChatChannel)(0),                    // 8: werewolf.v1.ChatChannel
+	(DeathCause)(0),                     // 9: werewolf.v1.DeathCause
+	(GameEvent_EventType)(0),            // 10: werewolf.v1.GameEvent.EventType
+	(QueueUpdate_Status)(0),             // 11: werewolf.v1.QueueUpdate.Status
+	(*ErrorDetail)(nil),                 // 12: werewolf.v1.ErrorDetail
+	(*Player)(nil),                      // 13: werewolf.v1.Player
+	(*NightAction)(nil),                 // 14: werewolf.v1.NightAction
+	(*PhaseInfo)(nil),                   // 15: werewolf.v1.PhaseInfo
+	(*AvailableAction)(nil),             // 16: werewolf.v1.AvailableAction
+	(*WitchPrompt)(nil),                 // 17: werewolf.v1.WitchPrompt
+	(*SeerResult)(nil),                  // 18: werewolf.v1.SeerResult
+	(*WitchPotionRecord)(nil),           // 19: werewolf.v1.WitchPotionRecord
+	(*GuardRecord)(nil),                 // 20: werewolf.v1.GuardRecord
+	(*PrivateKnowledge)(nil),            // 21: werewolf.v1.PrivateKnowledge
+	(*VoteTally)(nil),                   // 22: werewolf.v1.VoteTally
+	(*ChatMessage)(nil),                 // 23: werewolf.v1.ChatMessage
+	(*SpeakerTurn)(nil),                 // 24: werewolf.v1.SpeakerTurn
+	(*Ballot)(nil),                      // 25: werewolf.v1.Ballot
+	(*DeathReport)(nil),                 // 26: werewolf.v1.DeathReport
+	(*GameOverInfo)(nil),                // 27: werewolf.v1.GameOverInfo
+	(*PlayerReveal)(nil),                // 28: werewolf.v1.PlayerReveal
+	(*TimelineEntry)(nil),               // 29: werewolf.v1.TimelineEntry
+	(*GameReport)(nil),                  // 30: werewolf.v1.GameReport
+	(*VisibilityConfig)(nil),            // 31: werewolf.v1.VisibilityConfig
+	(*TimeoutPolicy)(nil),               // 32: werewolf.v1.TimeoutPolicy
+	(*CreateRoomRequest)(nil),           // 33: werewolf.v1.CreateRoomRequest
+	(*CreateRoomResponse)(nil),          // 34: werewolf.v1.CreateRoomResponse
+	(*JoinRoomRequest)(nil),             // 35: werewolf.v1.JoinRoomRequest
+	(*JoinRoomResponse)(nil),            // 36: werewolf.v1.JoinRoomResponse
+	(*StartGameRequest)(nil),            // 37: werewolf.v1.StartGameRequest
+	(*StartGameResponse)(nil),           // 38: werewolf.v1.StartGameResponse
+	(*NightActionRequest)(nil),          // 39: werewolf.v1.NightActionRequest
+	(*NightActionResponse)(nil),         // 40: werewolf.v1.NightActionResponse
+	(*VoteRequest)(nil),                 // 41: werewolf.v1.VoteRequest
+	(*VoteResponse)(nil),                // 42: werewolf.v1.VoteResponse
+	(*GetGameStateRequest)(nil),         // 43: werewolf.v1.GetGameStateRequest
+	(*GetGameStateResponse)(nil),        // 44: werewolf.v1.GetGameStateResponse
+	(*GetAvailableActionsRequest)(nil),  // 45: werewolf.v1.GetAvailableActionsRequest
+	(*GetAvailableActionsResponse)(nil), // 46: werewolf.v1.GetAvailableActionsResponse
+	(*GameEvent)(nil),                   // 47: werewolf.v1.GameEvent
+	(*SendChatMessageRequest)(nil),      // 48: werewolf.v1.SendChatMessageRequest
+	(*SendChatMessageResponse)(nil),     // 49: werewolf.v1.SendChatMessageResponse
+	(*SessionJoin)(nil),                 // 50: werewolf.v1.SessionJoin
+	(*SessionAck)(nil),                  // 51: werewolf.v1.SessionAck
+	(*SessionReady)(nil),                // 52: werewolf.v1.SessionReady
+	(*SessionEndSpeech)(nil),            // 53: werewolf.v1.SessionEndSpeech
+	(*SessionActionResult)(nil),         // 54: werewolf.v1.SessionActionResult
+	(*SessionError)(nil),                // 55: werewolf.v1.SessionError
+	(*SessionRequest)(nil),              // 56: werewolf.v1.SessionRequest
+	(*SessionResponse)(nil),             // 57: werewolf.v1.SessionResponse
+	(*ListRoomsRequest)(nil),            // 58: werewolf.v1.ListRoomsRequest
+	(*RoomSummary)(nil),                 // 59: werewolf.v1.RoomSummary
+	(*ListRoomsResponse)(nil),           // 60: werewolf.v1.ListRoomsResponse
+	(*GetGameReportRequest)(nil),        // 61: werewolf.v1.GetGameReportRequest
+	(*GetGameReportResponse)(nil),       // 62: werewolf.v1.GetGameReportResponse
+	(*JoinQueueRequest)(nil),            // 63: werewolf.v1.JoinQueueRequest
+	(*QueueUpdate)(nil),                 // 64: werewolf.v1.QueueUpdate
+	(*SubscribeRoomRequest)(nil),        // 65: werewolf.v1.SubscribeRoomRequest
+	(*RoomEvent)(nil),                   // 66: werewolf.v1.RoomEvent
+	(*StopSpectatingRequest)(nil),       // 67: werewolf.v1.StopSpectatingRequest
+	(*StopSpectatingResponse)(nil),      // 68: werewolf.v1.StopSpectatingResponse
+	(*GatewayPing)(nil),                 // 69: werewolf.v1.GatewayPing
+	(*GatewayPong)(nil),                 // 70: werewolf.v1.GatewayPong
+	(*ClientFrame)(nil),                 // 71: werewolf.v1.ClientFrame
+	(*ServerFrame)(nil),                 // 72: werewolf.v1.ServerFrame
+	nil,                                 // 73: werewolf.v1.VoteTally.CountsEntry
+	nil,                                 // 74: werewolf.v1.CreateRoomRequest.RoleConfigEntry
+	nil,                                 // 75: werewolf.v1.GameEvent.ExtraDataEntry
+}
+var file_v1_werewolf_proto_depIdxs = []int32{
+	7,   // 0: werewolf.v1.ErrorDetail.reason:type_name -> werewolf.v1.ErrorReason
+	2,   // 1: werewolf.v1.Player.role:type_name -> werewolf.v1.Role
+	3,   // 2: werewolf.v1.Player.camp:type_name -> werewolf.v1.Camp
+	6,   // 3: werewolf.v1.Player.presence:type_name -> werewolf.v1.PresenceStatus
+	2,   // 4: werewolf.v1.NightAction.role:type_name -> werewolf.v1.Role
+	4,   // 5: werewolf.v1.NightAction.action:type_name -> werewolf.v1.ActionType
+	0,   // 6: werewolf.v1.PhaseInfo.current_phase:type_name -> werewolf.v1.Phase
+	4,   // 7: werewolf.v1.AvailableAction.action:type_name -> werewolf.v1.ActionType
+	3,   // 8: werewolf.v1.SeerResult.camp:type_name -> werewolf.v1.Camp
+	4,   // 9: werewolf.v1.WitchPotionRecord.action:type_name -> werewolf.v1.ActionType
+	18,  // 10: werewolf.v1.PrivateKnowledge.seer_checks:type_name -> werewolf.v1.SeerResult
+	19,  // 11: werewolf.v1.PrivateKnowledge.witch_potions:type_name -> werewolf.v1.WitchPotionRecord
+	20,  // 12: werewolf.v1.PrivateKnowledge.guard_records:type_name -> werewolf.v1.GuardRecord
+	73,  // 13: werewolf.v1.VoteTally.counts:type_name -> werewolf.v1.VoteTally.CountsEntry
+	25,  // 14: werewolf.v1.VoteTally.ballots:type_name -> werewolf.v1.Ballot
+	8,   // 15: werewolf.v1.ChatMessage.channel:type_name -> werewolf.v1.ChatChannel
+	3,   // 16: werewolf.v1.GameOverInfo.winner:type_name -> werewolf.v1.Camp
+	30,  // 17: werewolf.v1.GameOverInfo.report:type_name -> werewolf.v1.GameReport
+	13,  // 18: werewolf.v1.PlayerReveal.player:type_name -> werewolf.v1.Player
+	9,   // 19: werewolf.v1.PlayerReveal.death_cause:type_name -> werewolf.v1.DeathCause
+	0,   // 20: werewolf.v1.TimelineEntry.phase:type_name -> werewolf.v1.Phase
+	4,   // 21: werewolf.v1.TimelineEntry.action:type_name -> werewolf.v1.ActionType
+	9,   // 22: werewolf.v1.TimelineEntry.death_cause:type_name -> werewolf.v1.DeathCause
+	3,   // 23: werewolf.v1.GameReport.winner:type_name -> werewolf.v1.Camp
+	28,  // 24: werewolf.v1.GameReport.players:type_name -> werewolf.v1.PlayerReveal
+	29,  // 25: werewolf.v1.GameReport.timeline:type_name -> werewolf.v1.TimelineEntry
+	22,  // 26: werewolf.v1.GameReport.votes:type_name -> werewolf.v1.VoteTally
+	74,  // 27: werewolf.v1.CreateRoomRequest.role_config:type_name -> werewolf.v1.CreateRoomRequest.RoleConfigEntry
+	31,  // 28: werewolf.v1.CreateRoomRequest.visibility:type_name -> werewolf.v1.VisibilityConfig
+	32,  // 29: werewolf.v1.CreateRoomRequest.timeout_policy:type_name -> werewolf.v1.TimeoutPolicy
+	5,   // 30: werewolf.v1.CreateRoomRequest.vote_mode:type_name -> werewolf.v1.VoteMode
+	13,  // 31: werewolf.v1.JoinRoomResponse.player:type_name -> werewolf.v1.Player
+	7,   // 32: werewolf.v1.JoinRoomResponse.reason:type_name -> werewolf.v1.ErrorReason
+	15,  // 33: werewolf.v1.StartGameResponse.phase_info:type_name -> werewolf.v1.PhaseInfo
+	7,   // 34: werewolf.v1.StartGameResponse.reason:type_name -> werewolf.v1.ErrorReason
+	4,   // 35: werewolf.v1.NightActionRequest.action:type_name -> werewolf.v1.ActionType
+	18,  // 36: werewolf.v1.NightActionResponse.seer_result:type_name -> werewolf.v1.SeerResult
+	7,   // 37: werewolf.v1.NightActionResponse.reason:type_name -> werewolf.v1.ErrorReason
+	7,   // 38: werewolf.v1.VoteResponse.reason:type_name -> werewolf.v1.ErrorReason
+	1,   // 39: werewolf.v1.GetGameStateResponse.state:type_name -> werewolf.v1.GameState
+	15,  // 40: werewolf.v1.GetGameStateResponse.phase_info:type_name -> werewolf.v1.PhaseInfo
+	13,  // 41: werewolf.v1.GetGameStateResponse.players:type_name -> werewolf.v1.Player
+	13,  // 42: werewolf.v1.GetGameStateResponse.current_player:type_name -> werewolf.v1.Player
+	21,  // 43: werewolf.v1.GetGameStateResponse.knowledge:type_name -> werewolf.v1.PrivateKnowledge
+	22,  // 44: werewolf.v1.GetGameStateResponse.vote_history:type_name -> werewolf.v1.VoteTally
+	0,   // 45: werewolf.v1.GetAvailableActionsResponse.phase:type_name -> werewolf.v1.Phase
+	16,  // 46: werewolf.v1.GetAvailableActionsResponse.actions:type_name -> werewolf.v1.AvailableAction
+	10,  // 47: werewolf.v1.GameEvent.event_type:type_name -> werewolf.v1.GameEvent.EventType
+	15,  // 48: werewolf.v1.GameEvent.phase_info:type_name -> werewolf.v1.PhaseInfo
+	13,  // 49: werewolf.v1.GameEvent.affected_players:type_name -> werewolf.v1.Player
+	75,  // 50: werewolf.v1.GameEvent.extra_data:type_name -> werewolf.v1.GameEvent.ExtraDataEntry
+	16,  // 51: werewolf.v1.GameEvent.available_actions:type_name -> werewolf.v1.AvailableAction
+	17,  // 52: werewolf.v1.GameEvent.witch_prompt:type_name -> werewolf.v1.WitchPrompt
+	18,  // 53: werewolf.v1.GameEvent.seer_result:type_name -> werewolf.v1.SeerResult
+	22,  // 54: werewolf.v1.GameEvent.vote_tally:type_name -> werewolf.v1.VoteTally
+	26,  // 55: werewolf.v1.GameEvent.death_report:type_name -> werewolf.v1.DeathReport
+	27,  // 56: werewolf.v1.GameEvent.game_over:type_name -> werewolf.v1.GameOverInfo
+	19,  // 57: werewolf.v1.GameEvent.witch_potion:type_name -> werewolf.v1.WitchPotionRecord
+	20,  // 58: werewolf.v1.GameEvent.guard_record:type_name -> werewolf.v1.GuardRecord
+	23,  // 59: werewolf.v1.GameEvent.chat:type_name -> werewolf.v1.ChatMessage
+	24,  // 60: werewolf.v1.GameEvent.speaker_turn:type_name -> werewolf.v1.SpeakerTurn
+	8,   // 61: werewolf.v1.SendChatMessageRequest.channel:type_name -> werewolf.v1.ChatChannel
+	7,   // 62: werewolf.v1.SendChatMessageResponse.reason:type_name -> werewolf.v1.ErrorReason
+	7,   // 63: werewolf.v1.SessionActionResult.reason:type_name -> werewolf.v1.ErrorReason
+	7,   // 64: werewolf.v1.SessionError.reason:type_name -> werewolf.v1.ErrorReason
+	50,  // 65: werewolf.v1.SessionRequest.join:type_name -> werewolf.v1.SessionJoin
+	39,  // 66: werewolf.v1.SessionRequest.night_action:type_name -> werewolf.v1.NightActionRequest
+	41,  // 67: werewolf.v1.SessionRequest.vote:type_name -> werewolf.v1.VoteRequest
+	48,  // 68: werewolf.v1.SessionRequest.chat:type_name -> werewolf.v1.SendChatMessageRequest
+	51,  // 69: werewolf.v1.SessionRequest.ack:type_name -> werewolf.v1.SessionAck
+	52,  // 70: werewolf.v1.SessionRequest.ready:type_name -> werewolf.v1.SessionReady
+	53,  // 71: werewolf.v1.SessionRequest.end_speech:type_name -> werewolf.v1.SessionEndSpeech
+	47,  // 72: werewolf.v1.SessionResponse.event:type_name -> werewolf.v1.GameEvent
+	36,  // 73: werewolf.v1.SessionResponse.join:type_name -> werewolf.v1.JoinRoomResponse
+	40,  // 74: werewolf.v1.SessionResponse.night_action:type_name -> werewolf.v1.NightActionResponse
+	42,  // 75: werewolf.v1.SessionResponse.vote:type_name -> werewolf.v1.VoteResponse
+	49,  // 76: werewolf.v1.SessionResponse.chat:type_name -> werewolf.v1.SendChatMessageResponse
+	55,  // 77: werewolf.v1.SessionResponse.error:type_name -> werewolf.v1.SessionError
+	54,  // 78: werewolf.v1.SessionResponse.ready:type_name -> werewolf.v1.SessionActionResult
+	54,  // 79: werewolf.v1.SessionResponse.end_speech:type_name -> werewolf.v1.SessionActionResult
+	1,   // 80: werewolf.v1.RoomSummary.state:type_name -> werewolf.v1.GameState
+	59,  // 81: werewolf.v1.ListRoomsResponse.rooms:type_name -> werewolf.v1.RoomSummary
+	30,  // 82: werewolf.v1.GetGameReportResponse.report:type_name -> werewolf.v1.GameReport
+	11,  // 83: werewolf.v1.QueueUpdate.status:type_name -> werewolf.v1.QueueUpdate.Status
+	47,  // 84: werewolf.v1.RoomEvent.event:type_name -> werewolf.v1.GameEvent
+	7,   // 85: werewolf.v1.StopSpectatingResponse.reason:type_name -> werewolf.v1.ErrorReason
+	56,  // 86: werewolf.v1.ClientFrame.request:type_name -> werewolf.v1.SessionRequest
+	69,  // 87: werewolf.v1.ClientFrame.ping:type_name -> werewolf.v1.GatewayPing
+	57,  // 88: werewolf.v1.ServerFrame.session:type_name -> werewolf.v1.SessionResponse
+	64,  // 89: werewolf.v1.ServerFrame.queue_update:type_name -> werewolf.v1.QueueUpdate
+	70,  // 90: werewolf.v1.ServerFrame.pong:type_name -> werewolf.v1.GatewayPong
+	33,  // 91: werewolf.v1.WerewolfService.CreateRoom:input_type -> werewolf.v1.CreateRoomRequest
+	35,  // 92: werewolf.v1.WerewolfService.JoinRoom:input_type -> werewolf.v1.JoinRoomRequest
+	58,  // 93: werewolf.v1.WerewolfService.ListRooms:input_type -> werewolf.v1.ListRoomsRequest
+	37,  // 94: werewolf.v1.WerewolfService.StartGame:input_type -> werewolf.v1.StartGameRequest
+	39,  // 95: werewolf.v1.WerewolfService.NightAction:input_type -> werewolf.v1.NightActionRequest
+	41,  // 96: werewolf.v1.WerewolfService.Vote:input_type -> werewolf.v1.VoteRequest
+	43,  // 97: werewolf.v1.WerewolfService.GetGameState:input_type -> werewolf.v1.GetGameStateRequest
+	45,  // 98: werewolf.v1.WerewolfService.GetAvailableActions:input_type -> werewolf.v1.GetAvailableActionsRequest
+	61,  // 99: werewolf.v1.WerewolfService.GetGameReport:input_type -> werewolf.v1.GetGameReportRequest
+	43,  // 100: werewolf.v1.WerewolfService.SubscribeGameEvents:input_type -> werewolf.v1.GetGameStateRequest
+	48,  // 101: werewolf.v1.WerewolfService.SendChatMessage:input_type -> werewolf.v1.SendChatMessageRequest
+	56,  // 102: werewolf.v1.WerewolfService.GameSession:input_type -> werewolf.v1.SessionRequest
+	63,  // 103: werewolf.v1.WerewolfService.JoinQueue:input_type -> werewolf.v1.JoinQueueRequest
+	65,  // 104: werewolf.v1.WerewolfService.SubscribeRoom:input_type -> werewolf.v1.SubscribeRoomRequest
+	67,  // 105: werewolf.v1.WerewolfService.StopSpectating:input_type -> werewolf.v1.StopSpectatingRequest
+	34,  // 106: werewolf.v1.WerewolfService.CreateRoom:output_type -> werewolf.v1.CreateRoomResponse
+	36,  // 107: werewolf.v1.WerewolfService.JoinRoom:output_type -> werewolf.v1.JoinRoomResponse
+	60,  // 108: werewolf.v1.WerewolfService.ListRooms:output_type -> werewolf.v1.ListRoomsResponse
+	38,  // 109: werewolf.v1.WerewolfService.StartGame:output_type -> werewolf.v1.StartGameResponse
+	40,  // 110: werewolf.v1.WerewolfService.NightAction:output_type -> werewolf.v1.NightActionResponse
+	42,  // 111: werewolf.v1.WerewolfService.Vote:output_type -> werewolf.v1.VoteResponse
+	44,  // 112: werewolf.v1.WerewolfService.GetGameState:output_type -> werewolf.v1.GetGameStateResponse
+	46,  // 113: werewolf.v1.WerewolfService.GetAvailableActions:output_type -> werewolf.v1.GetAvailableActionsResponse
+	62,  // 114: werewolf.v1.WerewolfService.GetGameReport:output_type -> werewolf.v1.GetGameReportResponse
+	47,  // 115: werewolf.v1.WerewolfService.SubscribeGameEvents:output_type -> werewolf.v1.GameEvent
+	49,  // 116: werewolf.v1.WerewolfService.SendChatMessage:output_type -> werewolf.v1.SendChatMessageResponse
+	57,  // 117: werewolf.v1.WerewolfService.GameSession:output_type -> werewolf.v1.SessionResponse
+	64,  // 118: werewolf.v1.WerewolfService.JoinQueue:output_type -> werewolf.v1.QueueUpdate
+	66,  // 119: werewolf.v1.WerewolfService.SubscribeRoom:output_type -> werewolf.v1.RoomEvent
+	68,  // 120: werewolf.v1.WerewolfService.StopSpectating:output_type -> werewolf.v1.StopSpectatingResponse
+	106, // [106:121] is the sub-list for method output_type
+	91,  // [91:106] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
+}
+
+func init() { file_v1_werewolf_proto_init() }
+func file_v1_werewolf_proto_init() {
+	if File_v1_werewolf_proto != nil {
+		return
+	}
+	file_v1_werewolf_proto_msgTypes[35].OneofWrappers = []any{
+		(*GameEvent_WitchPrompt)(nil),
+		(*GameEvent_SeerResult)(nil),
+		(*GameEvent_VoteTally)(nil),
+		(*GameEvent_DeathReport)(nil),
+		(*GameEvent_GameOver)(nil),
+		(*GameEvent_WitchPotion)(nil),
+		(*GameEvent_GuardRecord)(nil),
+		(*GameEvent_Chat)(nil),
+		(*GameEvent_SpeakerTurn)(nil),
+	}
+	file_v1_werewolf_proto_msgTypes[44].OneofWrappers = []any{
+		(*SessionRequest_Join)(nil),
+		(*SessionRequest_NightAction)(nil),
+		(*SessionRequest_Vote)(nil),
+		(*SessionRequest_Chat)(nil),
+		(*SessionRequest_Ack)(nil),
+		(*SessionRequest_Ready)(nil),
+		(*SessionRequest_EndSpeech)(nil),
+	}
+	file_v1_werewolf_proto_msgTypes[45].OneofWrappers = []any{
+		(*SessionResponse_Event)(nil),
+		(*SessionResponse_Join)(nil),
+		(*SessionResponse_NightAction)(nil),
+		(*SessionResponse_Vote)(nil),
+		(*SessionResponse_Chat)(nil),
+		(*SessionResponse_Error)(nil),
+		(*SessionResponse_Ready)(nil),
+		(*SessionResponse_EndSpeech)(nil),
+	}
+	file_v1_werewolf_proto_msgTypes[59].OneofWrappers = []any{
+		(*ClientFrame_Request)(nil),
+		(*ClientFrame_Ping)(nil),
+	}
+	file_v1_werewolf_proto_msgTypes[60].OneofWrappers = []any{
+		(*ServerFrame_Session)(nil),
+		(*ServerFrame_QueueUpdate)(nil),
+		(*ServerFrame_Pong)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_werewolf_proto_rawDesc), len(file_v1_werewolf_proto_rawDesc)),
+			NumEnums:      12,
+			NumMessages:   64,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_werewolf_proto_goTypes,
+		DependencyIndexes: file_v1_werewolf_proto_depIdxs,
+		EnumInfos:         file_v1_werewolf_proto_enumTypes,
+		MessageInfos:      file_v1_werewolf_proto_msgTypes,
+	}.Build()
+	File_v1_werewolf_proto = out.File
+	file_v1_werewolf_proto_goTypes = nil
+	file_v1_werewolf_proto_depIdxs = nil
+}
@@ -0,0 +1,675 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: v1/werewolf.proto
+
+// werewolf.v1 合并了原 werewolf.proto 与 werewolf_2.proto。
+// 兼容期内：
+//   1. 服务端同时以旧服务名 werewolf.WerewolfService 注册，旧客户端无需修改；
+//   2. 所有字段编号保持不变，标记为 deprecated 的字段仍会被填充；
+//   3. 兼容期结束后（v2）将删除 deprecated 字段和旧服务名。
+
+package werewolfv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WerewolfService_CreateRoom_FullMethodName          = "/werewolf.v1.WerewolfService/CreateRoom"
+	WerewolfService_JoinRoom_FullMethodName            = "/werewolf.v1.WerewolfService/JoinRoom"
+	WerewolfService_ListRooms_FullMethodName           = "/werewolf.v1.WerewolfService/ListRooms"
+	WerewolfService_StartGame_FullMethodName           = "/werewolf.v1.WerewolfService/StartGame"
+	WerewolfService_NightAction_FullMethodName         = "/werewolf.v1.WerewolfService/NightAction"
+	WerewolfService_Vote_FullMethodName                = "/werewolf.v1.WerewolfService/Vote"
+	WerewolfService_GetGameState_FullMethodName        = "/werewolf.v1.WerewolfService/GetGameState"
+	WerewolfService_GetAvailableActions_FullMethodName = "/werewolf.v1.WerewolfService/GetAvailableActions"
+	WerewolfService_GetGameReport_FullMethodName       = "/werewolf.v1.WerewolfService/GetGameReport"
+	WerewolfService_SubscribeGameEvents_FullMethodName = "/werewolf.v1.WerewolfService/SubscribeGameEvents"
+	WerewolfService_SendChatMessage_FullMethodName     = "/werewolf.v1.WerewolfService/SendChatMessage"
+	WerewolfService_GameSession_FullMethodName         = "/werewolf.v1.WerewolfService/GameSession"
+	WerewolfService_JoinQueue_FullMethodName           = "/werewolf.v1.WerewolfService/JoinQueue"
+	WerewolfService_SubscribeRoom_FullMethodName       = "/werewolf.v1.WerewolfService/SubscribeRoom"
+	WerewolfService_StopSpectating_FullMethodName      = "/werewolf.v1.WerewolfService/StopSpectating"
+)
+
+// WerewolfServiceClient is the client API for WerewolfService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 狼人杀服务
+type WerewolfServiceClient interface {
+	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error)
+	JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*JoinRoomResponse, error)
+	ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error)
+	StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*StartGameResponse, error)
+	NightAction(ctx context.Context, in *NightActionRequest, opts ...grpc.CallOption) (*NightActionResponse, error)
+	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error)
+	GetGameState(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (*GetGameStateResponse, error)
+	GetAvailableActions(ctx context.Context, in *GetAvailableActionsRequest, opts ...grpc.CallOption) (*GetAvailableActionsResponse, error)
+	GetGameReport(ctx context.Context, in *GetGameReportRequest, opts ...grpc.CallOption) (*GetGameReportResponse, error)
+	SubscribeGameEvents(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error)
+	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error)
+	// 双向流会话：客户端发送加入、行动、投票、聊天和确认，服务端推送事件、结果和错误
+	// 行动、投票和聊天由会话执行；一元 NightAction、Vote、SendChatMessage 作为兼容层保留，为单个请求建立临时会话
+	GameSession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SessionRequest, SessionResponse], error)
+	// 排队匹配：按等级分分组，人满后自动创建房间并开始游戏
+	JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueueUpdate], error)
+	// 网关的房间级订阅，多个本地连接共用一条流
+	SubscribeRoom(ctx context.Context, in *SubscribeRoomRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RoomEvent], error)
+	StopSpectating(ctx context.Context, in *StopSpectatingRequest, opts ...grpc.CallOption) (*StopSpectatingResponse, error)
+}
+
+type werewolfServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWerewolfServiceClient(cc grpc.ClientConnInterface) WerewolfServiceClient {
+	return &werewolfServiceClient{cc}
+}
+
+func (c *werewolfServiceClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoomResponse)
+	err := c.cc.Invoke(ctx, WerewolfService_CreateRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *werewolfServiceClient) JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*JoinRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRoomResponse)
+	err := c.cc.Invoke(ctx, WerewolfService_JoinRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *werewolfServiceClient) ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoomsResponse)
+	err := c.cc.Invoke(ctx, WerewolfService_ListRooms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *werewolfServiceClient) StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*StartGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartGameResponse)
+	err := c.cc.Invoke(ctx, WerewolfService_StartGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *werewolfServiceClient) NightAction(ctx context.Context, in *NightActionRequest, opts ...grpc.CallOption) (*NightActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NightActionResponse)
+	err := c.cc.Invoke(ctx, WerewolfService_NightAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *werewolfServiceClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, WerewolfService_Vote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *werewolfServiceClient) GetGameState(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (*GetGameStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGameStateResponse)
+	err := c.cc.Invoke(ctx, WerewolfService_GetGameState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *werewolfServiceClient) GetAvailableActions(ctx context.Context, in *GetAvailableActionsRequest, opts ...grpc.CallOption) (*GetAvailableActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailableActionsResponse)
+	err := c.cc.Invoke(ctx, WerewolfService_GetAvailableActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *werewolfServiceClient) GetGameReport(ctx context.Context, in *GetGameReportRequest, opts ...grpc.CallOption) (*GetGameReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGameReportResponse)
+	err := c.cc.Invoke(ctx, WerewolfService_GetGameReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *werewolfServiceClient) SubscribeGameEvents(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WerewolfService_ServiceDesc.Streams[0], WerewolfService_SubscribeGameEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetGameStateRequest, GameEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WerewolfService_SubscribeGameEventsClient = grpc.ServerStreamingClient[GameEvent]
+
+func (c *werewolfServiceClient) SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendChatMessageResponse)
+	err := c.cc.Invoke(ctx, WerewolfService_SendChatMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *werewolfServiceClient) GameSession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SessionRequest, SessionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WerewolfService_ServiceDesc.Streams[1], WerewolfService_GameSession_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SessionRequest, SessionResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WerewolfService_GameSessionClient = grpc.BidiStreamingClient[SessionRequest, SessionResponse]
+
+func (c *werewolfServiceClient) JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueueUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WerewolfService_ServiceDesc.Streams[2], WerewolfService_JoinQueue_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[JoinQueueRequest, QueueUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WerewolfService_JoinQueueClient = grpc.ServerStreamingClient[QueueUpdate]
+
+func (c *werewolfServiceClient) SubscribeRoom(ctx context.Context, in *SubscribeRoomRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RoomEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WerewolfService_ServiceDesc.Streams[3], WerewolfService_SubscribeRoom_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRoomRequest, RoomEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WerewolfService_SubscribeRoomClient = grpc.ServerStreamingClient[RoomEvent]
+
+func (c *werewolfServiceClient) StopSpectating(ctx context.Context, in *StopSpectatingRequest, opts ...grpc.CallOption) (*StopSpectatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopSpectatingResponse)
+	err := c.cc.Invoke(ctx, WerewolfService_StopSpectating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WerewolfServiceServer is the server API for WerewolfService service.
+// All implementations must embed UnimplementedWerewolfServiceServer
+// for forward compatibility.
+//
+// 狼人杀服务
+type WerewolfServiceServer interface {
+	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
+	JoinRoom(context.Context, *JoinRoomRequest) (*JoinRoomResponse, error)
+	ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error)
+	StartGame(context.Context, *StartGameRequest) (*StartGameResponse, error)
+	NightAction(context.Context, *NightActionRequest) (*NightActionResponse, error)
+	Vote(context.Context, *VoteRequest) (*VoteResponse, error)
+	GetGameState(context.Context, *GetGameStateRequest) (*GetGameStateResponse, error)
+	GetAvailableActions(context.Context, *GetAvailableActionsRequest) (*GetAvailableActionsResponse, error)
+	GetGameReport(context.Context, *GetGameReportRequest) (*GetGameReportResponse, error)
+	SubscribeGameEvents(*GetGameStateRequest, grpc.ServerStreamingServer[GameEvent]) error
+	SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error)
+	// 双向流会话：客户端发送加入、行动、投票、聊天和确认，服务端推送事件、结果和错误
+	// 行动、投票和聊天由会话执行；一元 NightAction、Vote、SendChatMessage 作为兼容层保留，为单个请求建立临时会话
+	GameSession(grpc.BidiStreamingServer[SessionRequest, SessionResponse]) error
+	// 排队匹配：按等级分分组，人满后自动创建房间并开始游戏
+	JoinQueue(*JoinQueueRequest, grpc.ServerStreamingServer[QueueUpdate]) error
+	// 网关的房间级订阅，多个本地连接共用一条流
+	SubscribeRoom(*SubscribeRoomRequest, grpc.ServerStreamingServer[RoomEvent]) error
+	StopSpectating(context.Context, *StopSpectatingRequest) (*StopSpectatingResponse, error)
+	mustEmbedUnimplementedWerewolfServiceServer()
+}
+
+// UnimplementedWerewolfServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWerewolfServiceServer struct{}
+
+func (UnimplementedWerewolfServiceServer) CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (UnimplementedWerewolfServiceServer) JoinRoom(context.Context, *JoinRoomRequest) (*JoinRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinRoom not implemented")
+}
+func (UnimplementedWerewolfServiceServer) ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRooms not implemented")
+}
+func (UnimplementedWerewolfServiceServer) StartGame(context.Context, *StartGameRequest) (*StartGameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedWerewolfServiceServer) NightAction(context.Context, *NightActionRequest) (*NightActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method NightAction not implemented")
+}
+func (UnimplementedWerewolfServiceServer) Vote(context.Context, *VoteRequest) (*VoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Vote not implemented")
+}
+func (UnimplementedWerewolfServiceServer) GetGameState(context.Context, *GetGameStateRequest) (*GetGameStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGameState not implemented")
+}
+func (UnimplementedWerewolfServiceServer) GetAvailableActions(context.Context, *GetAvailableActionsRequest) (*GetAvailableActionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAvailableActions not implemented")
+}
+func (UnimplementedWerewolfServiceServer) GetGameReport(context.Context, *GetGameReportRequest) (*GetGameReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGameReport not implemented")
+}
+func (UnimplementedWerewolfServiceServer) SubscribeGameEvents(*GetGameStateRequest, grpc.ServerStreamingServer[GameEvent]) error {
+	return status.Error(codes.Unimplemented, "method SubscribeGameEvents not implemented")
+}
+func (UnimplementedWerewolfServiceServer) SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendChatMessage not implemented")
+}
+func (UnimplementedWerewolfServiceServer) GameSession(grpc.BidiStreamingServer[SessionRequest, SessionResponse]) error {
+	return status.Error(codes.Unimplemented, "method GameSession not implemented")
+}
+func (UnimplementedWerewolfServiceServer) JoinQueue(*JoinQueueRequest, grpc.ServerStreamingServer[QueueUpdate]) error {
+	return status.Error(codes.Unimplemented, "method JoinQueue not implemented")
+}
+func (UnimplementedWerewolfServiceServer) SubscribeRoom(*SubscribeRoomRequest, grpc.ServerStreamingServer[RoomEvent]) error {
+	return status.Error(codes.Unimplemented, "method SubscribeRoom not implemented")
+}
+func (UnimplementedWerewolfServiceServer) StopSpectating(context.Context, *StopSpectatingRequest) (*StopSpectatingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopSpectating not implemented")
+}
+func (UnimplementedWerewolfServiceServer) mustEmbedUnimplementedWerewolfServiceServer() {}
+func (UnimplementedWerewolfServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeWerewolfServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WerewolfServiceServer will
+// result in compilation errors.
+type UnsafeWerewolfServiceServer interface {
+	mustEmbedUnimplementedWerewolfServiceServer()
+}
+
+func RegisterWerewolfServiceServer(s grpc.ServiceRegistrar, srv WerewolfServiceServer) {
+	// If the following call panics, it indicates UnimplementedWerewolfServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WerewolfService_ServiceDesc, srv)
+}
+
+func _WerewolfService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WerewolfServiceServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WerewolfService_CreateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WerewolfServiceServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WerewolfService_JoinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WerewolfServiceServer).JoinRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WerewolfService_JoinRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WerewolfServiceServer).JoinRoom(ctx, req.(*JoinRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WerewolfService_ListRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WerewolfServiceServer).ListRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WerewolfService_ListRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WerewolfServiceServer).ListRooms(ctx, req.(*ListRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WerewolfService_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WerewolfServiceServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WerewolfService_StartGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WerewolfServiceServer).StartGame(ctx, req.(*StartGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WerewolfService_NightAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NightActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WerewolfServiceServer).NightAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WerewolfService_NightAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WerewolfServiceServer).NightAction(ctx, req.(*NightActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WerewolfService_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WerewolfServiceServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WerewolfService_Vote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WerewolfServiceServer).Vote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WerewolfService_GetGameState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WerewolfServiceServer).GetGameState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WerewolfService_GetGameState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WerewolfServiceServer).GetGameState(ctx, req.(*GetGameStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WerewolfService_GetAvailableActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WerewolfServiceServer).GetAvailableActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WerewolfService_GetAvailableActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WerewolfServiceServer).GetAvailableActions(ctx, req.(*GetAvailableActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WerewolfService_GetGameReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WerewolfServiceServer).GetGameReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WerewolfService_GetGameReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WerewolfServiceServer).GetGameReport(ctx, req.(*GetGameReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WerewolfService_SubscribeGameEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetGameStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WerewolfServiceServer).SubscribeGameEvents(m, &grpc.GenericServerStream[GetGameStateRequest, GameEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WerewolfService_SubscribeGameEventsServer = grpc.ServerStreamingServer[GameEvent]
+
+func _WerewolfService_SendChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WerewolfServiceServer).SendChatMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WerewolfService_SendChatMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WerewolfServiceServer).SendChatMessage(ctx, req.(*SendChatMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WerewolfService_GameSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WerewolfServiceServer).GameSession(&grpc.GenericServerStream[SessionRequest, SessionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WerewolfService_GameSessionServer = grpc.BidiStreamingServer[SessionRequest, SessionResponse]
+
+func _WerewolfService_JoinQueue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JoinQueueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WerewolfServiceServer).JoinQueue(m, &grpc.GenericServerStream[JoinQueueRequest, QueueUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WerewolfService_JoinQueueServer = grpc.ServerStreamingServer[QueueUpdate]
+
+func _WerewolfService_SubscribeRoom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRoomRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WerewolfServiceServer).SubscribeRoom(m, &grpc.GenericServerStream[SubscribeRoomRequest, RoomEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WerewolfService_SubscribeRoomServer = grpc.ServerStreamingServer[RoomEvent]
+
+func _WerewolfService_StopSpectating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopSpectatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WerewolfServiceServer).StopSpectating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WerewolfService_StopSpectating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WerewolfServiceServer).StopSpectating(ctx, req.(*StopSpectatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WerewolfService_ServiceDesc is the grpc.ServiceDesc for WerewolfService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WerewolfService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "werewolf.v1.WerewolfService",
+	HandlerType: (*WerewolfServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRoom",
+			Handler:    _WerewolfService_CreateRoom_Handler,
+		},
+		{
+			MethodName: "JoinRoom",
+			Handler:    _WerewolfService_JoinRoom_Handler,
+		},
+		{
+			MethodName: "ListRooms",
+			Handler:    _WerewolfService_ListRooms_Handler,
+		},
+		{
+			MethodName: "StartGame",
+			Handler:    _WerewolfService_StartGame_Handler,
+		},
+		{
+			MethodName: "NightAction",
+			Handler:    _WerewolfService_NightAction_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _WerewolfService_Vote_Handler,
+		},
+		{
+			MethodName: "GetGameState",
+			Handler:    _WerewolfService_GetGameState_Handler,
+		},
+		{
+			MethodName: "GetAvailableActions",
+			Handler:    _WerewolfService_GetAvailableActions_Handler,
+		},
+		{
+			MethodName: "GetGameReport",
+			Handler:    _WerewolfService_GetGameReport_Handler,
+		},
+		{
+			MethodName: "SendChatMessage",
+			Handler:    _WerewolfService_SendChatMessage_Handler,
+		},
+		{
+			MethodName: "StopSpectating",
+			Handler:    _WerewolfService_StopSpectating_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeGameEvents",
+			Handler:       _WerewolfService_SubscribeGameEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GameSession",
+			Handler:       _WerewolfService_GameSession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "JoinQueue",
+			Handler:       _WerewolfService_JoinQueue_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeRoom",
+			Handler:       _WerewolfService_SubscribeRoom_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "v1/werewolf.proto",
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"liam/internal/models"
+	"liam/pkg/errors"
+	"strconv"
+
+	stdErr "errors"
+
+	"gorm.io/gorm"
+)
+
+type GameRepository interface {
+	SaveGame(ctx context.Context, game *models.Game) error
+	ListGames(ctx context.Context, playerID string, offset, limit int) ([]models.Game, int64, error)
+	GetGameByRoomID(ctx context.Context, roomID string) (*models.Game, error)
+}
+
+type gameRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewGameRepository(db *gorm.DB) GameRepository {
+	return &gameRepositoryImpl{db: db}
+}
+
+// SaveGame 在一个事务中写入对局、参与者和时间线，并累加参与者的战绩
+// 同一房间只归档一次，重试时已写入的对局直接返回成功
+func (r *gameRepositoryImpl) SaveGame(ctx context.Context, game *models.Game) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.Game{}).Where("room_id = ?", game.RoomID).Count(&count).Error; err != nil {
+			return errors.NewAppError(errors.ErrInternalError.Code, "Failed to check archived game", err)
+		}
+		if count > 0 {
+			return nil
+		}
+
+		if err := r.linkUsers(tx, game.Participants); err != nil {
+			return err
+		}
+
+		if err := tx.Create(game).Error; err != nil {
+			return errors.NewAppError(errors.ErrInternalError.Code, "Failed to archive game", err)
+		}
+		return applyGameStats(tx, game)
+	})
+}
+
+// linkUsers 玩家ID为已注册用户的ID时关联到该用户
+func (r *gameRepositoryImpl) linkUsers(tx *gorm.DB, participants []models.GameParticipant) error {
+	ids := make([]uint, 0, len(participants))
+	for _, p := range participants {
+		if id, err := strconv.ParseUint(p.PlayerID, 10, 64); err == nil {
+			ids = append(ids, uint(id))
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var existing []uint
+	if err := tx.Model(&models.User{}).Where("id IN ?", ids).Pluck("id", &existing).Error; err != nil {
+		return errors.NewAppError(errors.ErrInternalError.Code, "Failed to look up game participants", err)
+	}
+	known := make(map[string]uint, len(existing))
+	for _, id := range existing {
+		known[strconv.FormatUint(uint64(id), 10)] = id
+	}
+
+	for i := range participants {
+		if id, ok := known[participants[i].PlayerID]; ok {
+			participants[i].UserID = &id
+		}
+	}
+	return nil
+}
+
+// ListGames 按结束时间倒序分页查询对局，playerID 不为空时只返回该玩家参与的对局
+func (r *gameRepositoryImpl) ListGames(ctx context.Context, playerID string, offset, limit int) ([]models.Game, int64, error) {
+	var games []models.Game
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.Game{})
+	if playerID != "" {
+		query = query.Where("id IN (?)", r.db.Model(&models.GameParticipant{}).Select("game_id").Where("player_id = ?", playerID))
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errors.NewAppError(errors.ErrInternalError.Code, "Failed to count games", err)
+	}
+
+	result := query.Preload("Participants", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	}).Order("finished_at DESC").Offset(offset).Limit(limit).Find(&games)
+	if result.Error != nil {
+		return nil, 0, errors.NewAppError(errors.ErrInternalError.Code, "Failed to retrieve games from database", result.Error)
+	}
+	return games, total, nil
+}
+
+// GetGameByRoomID 查询单局的完整记录，包括参与者和时间线
+func (r *gameRepositoryImpl) GetGameByRoomID(ctx context.Context, roomID string) (*models.Game, error) {
+	var game models.Game
+	result := r.db.WithContext(ctx).
+		Preload("Participants", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position")
+		}).
+		Preload("Events", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Where("room_id = ?", roomID).
+		First(&game)
+	if result.Error != nil {
+		if stdErr.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.NewAppError(errors.ErrNotFound.Code, "Game not found", result.Error)
+		}
+		return nil, errors.NewAppError(errors.ErrInternalError.Code, "Failed to retrieve game from database", result.Error)
+	}
+	return &game, nil
+}
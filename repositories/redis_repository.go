@@ -11,6 +11,34 @@ type RedisRepository interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
 	Get(ctx context.Context, key string) (string, error)
 	Del(ctx context.Context, key string) error
+	// GetDel 读取并删除键，用于一次性凭证
+	GetDel(ctx context.Context, key string) (string, error)
+	// SetNX 键不存在时写入，返回是否写入，用于占用一次性的处理标记
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
+	// 有序集合，用于排行榜
+	ZIncrBy(ctx context.Context, key string, increment float64, member string) error
+	ZRevRangeWithScores(ctx context.Context, key string, start, stop int64) ([]redis.Z, error)
+	// ZIncrByTx 在一个 MULTI 事务中执行全部加分并设置过期时间，要么全部生效要么都不生效
+	ZIncrByTx(ctx context.Context, increments []ZIncrement, expirations map[string]time.Duration) error
+	Expire(ctx context.Context, key string, expiration time.Duration) error
+	// 哈希，用于在线状态
+	HSet(ctx context.Context, key string, values ...interface{}) error
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+	// 有序集合，按时间戳记录房间内的在线玩家
+	ZAdd(ctx context.Context, key string, score float64, member string) error
+	ZRem(ctx context.Context, key string, members ...interface{}) error
+	ZCount(ctx context.Context, key string, min, max string) (int64, error)
+	ZRemRangeByScore(ctx context.Context, key string, min, max string) error
+	// 发布订阅，用于网关实例之间的消息投递
+	Publish(ctx context.Context, channel string, message interface{}) error
+	Subscribe(ctx context.Context, channels ...string) *redis.PubSub
+}
+
+// ZIncrement 有序集合中一个成员的加分
+type ZIncrement struct {
+	Key       string
+	Member    string
+	Increment float64
 }
 
 type redisRepositoryImpl struct {
@@ -32,3 +60,68 @@ func (r *redisRepositoryImpl) Get(ctx context.Context, key string) (string, erro
 func (r *redisRepositoryImpl) Del(ctx context.Context, key string) error {
 	return r.client.Del(ctx, key).Err()
 }
+
+func (r *redisRepositoryImpl) GetDel(ctx context.Context, key string) (string, error) {
+	return r.client.GetDel(ctx, key).Result()
+}
+
+func (r *redisRepositoryImpl) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, key, value, expiration).Result()
+}
+
+func (r *redisRepositoryImpl) ZIncrBy(ctx context.Context, key string, increment float64, member string) error {
+	return r.client.ZIncrBy(ctx, key, increment, member).Err()
+}
+
+func (r *redisRepositoryImpl) ZRevRangeWithScores(ctx context.Context, key string, start, stop int64) ([]redis.Z, error) {
+	return r.client.ZRevRangeWithScores(ctx, key, start, stop).Result()
+}
+
+func (r *redisRepositoryImpl) ZIncrByTx(ctx context.Context, increments []ZIncrement, expirations map[string]time.Duration) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, inc := range increments {
+			pipe.ZIncrBy(ctx, inc.Key, inc.Increment, inc.Member)
+		}
+		for key, expiration := range expirations {
+			pipe.Expire(ctx, key, expiration)
+		}
+		return nil
+	})
+	return err
+}
+
+func (r *redisRepositoryImpl) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return r.client.Expire(ctx, key, expiration).Err()
+}
+
+func (r *redisRepositoryImpl) HSet(ctx context.Context, key string, values ...interface{}) error {
+	return r.client.HSet(ctx, key, values...).Err()
+}
+
+func (r *redisRepositoryImpl) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	return r.client.HGetAll(ctx, key).Result()
+}
+
+func (r *redisRepositoryImpl) ZAdd(ctx context.Context, key string, score float64, member string) error {
+	return r.client.ZAdd(ctx, key, &redis.Z{Score: score, Member: member}).Err()
+}
+
+func (r *redisRepositoryImpl) ZRem(ctx context.Context, key string, members ...interface{}) error {
+	return r.client.ZRem(ctx, key, members...).Err()
+}
+
+func (r *redisRepositoryImpl) ZCount(ctx context.Context, key string, min, max string) (int64, error) {
+	return r.client.ZCount(ctx, key, min, max).Result()
+}
+
+func (r *redisRepositoryImpl) ZRemRangeByScore(ctx context.Context, key string, min, max string) error {
+	return r.client.ZRemRangeByScore(ctx, key, min, max).Err()
+}
+
+func (r *redisRepositoryImpl) Publish(ctx context.Context, channel string, message interface{}) error {
+	return r.client.Publish(ctx, channel, message).Err()
+}
+
+func (r *redisRepositoryImpl) Subscribe(ctx context.Context, channels ...string) *redis.PubSub {
+	return r.client.Subscribe(ctx, channels...)
+}
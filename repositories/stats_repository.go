@@ -0,0 +1,189 @@
+package repositories
+
+import (
+	"context"
+	"liam/internal/models"
+	"liam/pkg/errors"
+	"math"
+
+	stdErr "errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StatsRepository interface {
+	GetPlayerStats(ctx context.Context, userID uint) (*models.PlayerStats, error)
+	GetPlayerRoleStats(ctx context.Context, userID uint) ([]models.PlayerRoleStats, error)
+}
+
+// eloK Elo 等级分的 K 值
+const eloK = 32
+
+type statsRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStatsRepository(db *gorm.DB) StatsRepository {
+	return &statsRepositoryImpl{db: db}
+}
+
+func (r *statsRepositoryImpl) GetPlayerStats(ctx context.Context, userID uint) (*models.PlayerStats, error) {
+	var stats models.PlayerStats
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&stats)
+	if result.Error != nil {
+		if stdErr.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.NewAppError(errors.ErrNotFound.Code, "Player stats not found", result.Error)
+		}
+		return nil, errors.NewAppError(errors.ErrInternalError.Code, "Failed to retrieve player stats from database", result.Error)
+	}
+	return &stats, nil
+}
+
+func (r *statsRepositoryImpl) GetPlayerRoleStats(ctx context.Context, userID uint) ([]models.PlayerRoleStats, error) {
+	var stats []models.PlayerRoleStats
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("role").Find(&stats).Error; err != nil {
+		return nil, errors.NewAppError(errors.ErrInternalError.Code, "Failed to retrieve player role stats from database", err)
+	}
+	return stats, nil
+}
+
+// applyGameStats 将一局的结果累加到参与者的战绩中，只统计已关联用户的参与者
+// 与对局写入在同一事务中执行，保证每局只统计一次
+func applyGameStats(tx *gorm.DB, game *models.Game) error {
+	ratings, err := participantRatings(tx, game.Participants)
+	if err != nil {
+		return err
+	}
+	campRatings := make(map[string][]int)
+	for i, p := range game.Participants {
+		campRatings[p.Camp] = append(campRatings[p.Camp], ratings[i])
+	}
+
+	for i, p := range game.Participants {
+		if p.UserID == nil {
+			continue
+		}
+
+		// 以双方阵营的平均等级分计算期望胜率
+		opponent := models.DefaultRating
+		for camp, rs := range campRatings {
+			if camp != p.Camp {
+				opponent = average(rs)
+			}
+		}
+		ratingDelta := eloDelta(average(campRatings[p.Camp]), opponent, p.Won)
+
+		delta := models.PlayerStats{
+			UserID:          *p.UserID,
+			Rating:          ratings[i] + ratingDelta,
+			GamesPlayed:     1,
+			Wins:            boolToInt(p.Won),
+			GamesSurvived:   boolToInt(p.Survived),
+			CorrectChecks:   p.CorrectChecks,
+			SuccessfulSaves: p.SuccessfulSaves,
+		}
+		if p.Camp == "CAMP_WEREWOLF" {
+			delta.WerewolfGames, delta.WerewolfWins = 1, delta.Wins
+		} else {
+			delta.VillagerGames, delta.VillagerWins = 1, delta.Wins
+		}
+
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"rating":           gorm.Expr("rating + ?", ratingDelta),
+				"games_played":     gorm.Expr("games_played + ?", delta.GamesPlayed),
+				"wins":             gorm.Expr("wins + ?", delta.Wins),
+				"werewolf_games":   gorm.Expr("werewolf_games + ?", delta.WerewolfGames),
+				"werewolf_wins":    gorm.Expr("werewolf_wins + ?", delta.WerewolfWins),
+				"villager_games":   gorm.Expr("villager_games + ?", delta.VillagerGames),
+				"villager_wins":    gorm.Expr("villager_wins + ?", delta.VillagerWins),
+				"games_survived":   gorm.Expr("games_survived + ?", delta.GamesSurvived),
+				"correct_checks":   gorm.Expr("correct_checks + ?", delta.CorrectChecks),
+				"successful_saves": gorm.Expr("successful_saves + ?", delta.SuccessfulSaves),
+			}),
+		}).Create(&delta).Error
+		if err != nil {
+			return errors.NewAppError(errors.ErrInternalError.Code, "Failed to update player stats", err)
+		}
+
+		roleDelta := models.PlayerRoleStats{
+			UserID: *p.UserID,
+			Role:   p.Role,
+			Games:  1,
+			Wins:   boolToInt(p.Won),
+		}
+		err = tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "role"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"games": gorm.Expr("games + ?", roleDelta.Games),
+				"wins":  gorm.Expr("wins + ?", roleDelta.Wins),
+			}),
+		}).Create(&roleDelta).Error
+		if err != nil {
+			return errors.NewAppError(errors.ErrInternalError.Code, "Failed to update player role stats", err)
+		}
+	}
+	return nil
+}
+
+// participantRatings 按参与者顺序返回当前等级分，未关联用户或没有战绩时为初始分
+func participantRatings(tx *gorm.DB, participants []models.GameParticipant) ([]int, error) {
+	userIDs := make([]uint, 0, len(participants))
+	for _, p := range participants {
+		if p.UserID != nil {
+			userIDs = append(userIDs, *p.UserID)
+		}
+	}
+
+	known := make(map[uint]int, len(userIDs))
+	if len(userIDs) > 0 {
+		var stats []models.PlayerStats
+		if err := tx.Select("user_id", "rating").Where("user_id IN ?", userIDs).Find(&stats).Error; err != nil {
+			return nil, errors.NewAppError(errors.ErrInternalError.Code, "Failed to load player ratings", err)
+		}
+		for _, s := range stats {
+			known[s.UserID] = s.Rating
+		}
+	}
+
+	ratings := make([]int, len(participants))
+	for i, p := range participants {
+		ratings[i] = models.DefaultRating
+		if p.UserID != nil {
+			if rating, ok := known[*p.UserID]; ok {
+				ratings[i] = rating
+			}
+		}
+	}
+	return ratings, nil
+}
+
+// eloDelta 按 Elo 公式计算一局的等级分变化
+func eloDelta(rating, opponent int, won bool) int {
+	expected := 1 / (1 + math.Pow(10, float64(opponent-rating)/400))
+	score := 0.0
+	if won {
+		score = 1
+	}
+	return int(math.Round(eloK * (score - expected)))
+}
+
+func average(values []int) int {
+	if len(values) == 0 {
+		return models.DefaultRating
+	}
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / len(values)
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -16,6 +16,8 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user *models.User) error
 	GetAllUser(ctx context.Context, offset, limit int) ([]models.User, int64, error)
 	GetUserByID(ctx context.Context, id uint) (*models.User, error)
+	// GetUsersByIDs 批量查询用户，不存在的ID会被忽略
+	GetUsersByIDs(ctx context.Context, ids []uint) ([]models.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
 	UpdateUser(ctx context.Context, user *models.User) error
 	DeleteUser(ctx context.Context, id uint) error
@@ -70,6 +72,18 @@ func (r *userRepositoryImpl) GetUserByID(ctx context.Context, id uint) (*models.
 	return &user, nil
 }
 
+func (r *userRepositoryImpl) GetUsersByIDs(ctx context.Context, ids []uint) ([]models.User, error) {
+	var users []models.User
+	if len(ids) == 0 {
+		return users, nil
+	}
+	result := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&users)
+	if result.Error != nil {
+		return nil, errors.NewAppError(errors.ErrInternalError.Code, "Failed to retrieve users from database", result.Error)
+	}
+	return users, nil
+}
+
 func (r *userRepositoryImpl) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
 	result := r.db.WithContext(ctx).Where("email = ?", email).First(&user)
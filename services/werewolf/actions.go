@@ -0,0 +1,155 @@
+package werewolf
+
+import (
+	"sort"
+
+	pb "liam/pkg/werewolf/v1"
+)
+
+// requestedAction 解析请求中的行动类型，兼容期内 action 未设置时回退到旧版字符串字段
+func requestedAction(req *pb.NightActionRequest) pb.ActionType {
+	if req.Action != pb.ActionType_ACTION_UNKNOWN {
+		return req.Action
+	}
+	return pb.ParseActionType(req.ActionType)
+}
+
+// unlimitedUses 表示行动不限次数
+const unlimitedUses = -1
+
+// availableActions 计算玩家在当前阶段可执行的行动及合法目标
+// 调用方需持有 room.mu
+func (room *GameRoom) availableActions(player *pb.Player) []*pb.AvailableAction {
+	if player == nil || !player.IsAlive || !player.CanAct {
+		return nil
+	}
+
+	switch room.CurrentPhase {
+	case pb.Phase_PHASE_NIGHT_GUARD:
+		if player.Role != pb.Role_GUARD {
+			return nil
+		}
+		return []*pb.AvailableAction{
+			targetAction(pb.ActionType_ACTION_GUARD, room.alivePlayerIDs(""), "保护一名玩家"),
+			skipAction("今晚不守护"),
+		}
+
+	case pb.Phase_PHASE_NIGHT_WEREWOLF:
+		if player.Role != pb.Role_WEREWOLF {
+			return nil
+		}
+		return []*pb.AvailableAction{
+			targetAction(pb.ActionType_ACTION_KILL, room.alivePlayerIDs(""), "选择击杀对象"),
+		}
+
+	case pb.Phase_PHASE_NIGHT_WITCH:
+		if player.Role != pb.Role_WITCH {
+			return nil
+		}
+		actions := make([]*pb.AvailableAction, 0, 3)
+		if victimID := room.nightVictimID(); victimID != "" && !room.WitchSaveUsed {
+			save := targetAction(pb.ActionType_ACTION_SAVE, []string{victimID}, "使用解药救活今晚死亡的玩家")
+			save.RemainingUses = 1
+			actions = append(actions, save)
+		}
+		if !room.WitchPoisonUsed {
+			poison := targetAction(pb.ActionType_ACTION_POISON, room.alivePlayerIDs(player.PlayerId), "使用毒药毒杀一名玩家")
+			poison.RemainingUses = 1
+			actions = append(actions, poison)
+		}
+		return append(actions, skipAction("不使用药水"))
+
+	case pb.Phase_PHASE_NIGHT_SEER:
+		if player.Role != pb.Role_SEER {
+			return nil
+		}
+		return []*pb.AvailableAction{
+			targetAction(pb.ActionType_ACTION_CHECK, room.alivePlayerIDs(player.PlayerId), "查验一名玩家的身份"),
+		}
+
+	case pb.Phase_PHASE_DAY_VOTING:
+		if _, voted := room.Votes[player.PlayerId]; voted {
+			return nil
+		}
+		return []*pb.AvailableAction{
+			targetAction(pb.ActionType_ACTION_VOTE, room.alivePlayerIDs(player.PlayerId), "投票放逐一名玩家"),
+			untargetedAction(pb.ActionType_ACTION_ABSTAIN, "弃票"),
+		}
+	}
+
+	return nil
+}
+
+// validateAction 校验行动及目标是否合法，返回匹配的行动
+func (room *GameRoom) validateAction(player *pb.Player, actionType pb.ActionType, targetID string) (*pb.AvailableAction, bool) {
+	for _, action := range room.availableActions(player) {
+		if action.Action != actionType {
+			continue
+		}
+		if !action.RequiresTarget {
+			return action, true
+		}
+		for _, id := range action.TargetIds {
+			if id == targetID {
+				return action, true
+			}
+		}
+		return action, false
+	}
+	return nil, false
+}
+
+// nightVictimID 今晚被狼人击杀且未被守卫保护的玩家
+func (room *GameRoom) nightVictimID() string {
+	if room.WerewolfTarget != "" && room.WerewolfTarget != room.GuardTarget {
+		return room.WerewolfTarget
+	}
+	return ""
+}
+
+// alivePlayerIDs 按座位号返回存活玩家ID，exclude 为需要排除的玩家
+func (room *GameRoom) alivePlayerIDs(exclude string) []string {
+	ids := make([]string, 0, len(room.Players))
+	for _, player := range room.sortedPlayers() {
+		if player.IsAlive && player.PlayerId != exclude {
+			ids = append(ids, player.PlayerId)
+		}
+	}
+	return ids
+}
+
+// sortedPlayers 按座位号排序的玩家列表
+func (room *GameRoom) sortedPlayers() []*pb.Player {
+	players := make([]*pb.Player, 0, len(room.Players))
+	for _, player := range room.Players {
+		players = append(players, player)
+	}
+	sort.Slice(players, func(i, j int) bool {
+		return players[i].Position < players[j].Position
+	})
+	return players
+}
+
+func targetAction(actionType pb.ActionType, targets []string, description string) *pb.AvailableAction {
+	return &pb.AvailableAction{
+		Action:         actionType,
+		ActionType:     actionType.LegacyName(),
+		TargetIds:      targets,
+		RequiresTarget: true,
+		RemainingUses:  unlimitedUses,
+		Description:    description,
+	}
+}
+
+func skipAction(description string) *pb.AvailableAction {
+	return untargetedAction(pb.ActionType_ACTION_SKIP, description)
+}
+
+func untargetedAction(actionType pb.ActionType, description string) *pb.AvailableAction {
+	return &pb.AvailableAction{
+		Action:        actionType,
+		ActionType:    actionType.LegacyName(),
+		RemainingUses: unlimitedUses,
+		Description:   description,
+	}
+}
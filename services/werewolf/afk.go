@@ -0,0 +1,223 @@
+package werewolf
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	pb "liam/pkg/werewolf/v1"
+)
+
+// defaultAFKThreshold 连续超时多少次视为挂机
+const defaultAFKThreshold = 2
+
+// botActionDelay 机器人托管的行动延迟，玩家回来后可以抢先行动
+const botActionDelay = 3 * time.Second
+
+// handlePhaseTimeout 阶段超时：按房间策略补全默认结果，并累计未行动玩家的超时次数
+// 调用方需持有 room.mu
+func (room *GameRoom) handlePhaseTimeout(phase pb.Phase) {
+	idle := make([]*pb.Player, 0)
+	for _, player := range room.sortedPlayers() {
+		if player.IsAlive && player.CanAct {
+			idle = append(idle, player)
+		}
+	}
+
+	switch phase {
+	case pb.Phase_PHASE_NIGHT_WEREWOLF:
+		if room.WerewolfTarget == "" && room.TimeoutPolicy.GetWerewolfRandomKill() {
+			if targets := room.nonWerewolfTargets(room.alivePlayerIDs("")); len(targets) > 0 {
+				room.WerewolfTarget = targets[rand.Intn(len(targets))]
+				log.Printf("房间 %s: 狼人超时，随机击杀 %s", room.ID, room.WerewolfTarget)
+			}
+		}
+
+	case pb.Phase_PHASE_DAY_VOTING:
+		// 未投票按策略随机投票，否则视为弃票
+		for _, player := range idle {
+			targetID := ""
+			if targets := room.alivePlayerIDs(player.PlayerId); room.TimeoutPolicy.GetVoteRandomTarget() && len(targets) > 0 {
+				targetID = targets[rand.Intn(len(targets))]
+			}
+			room.Votes[player.PlayerId] = targetID
+			if targetID == "" {
+				room.recordAction(player.PlayerId, pb.ActionType_ACTION_ABSTAIN, "")
+			} else {
+				room.recordAction(player.PlayerId, pb.ActionType_ACTION_VOTE, targetID)
+			}
+		}
+
+	case pb.Phase_PHASE_NIGHT_SEER:
+		// 预言家超时按策略随机查验，否则本晚没有查验结果
+		if !room.TimeoutPolicy.GetSeerRandomCheck() {
+			break
+		}
+		for _, player := range idle {
+			if targets := room.alivePlayerIDs(player.PlayerId); player.Role == pb.Role_SEER && len(targets) > 0 {
+				room.randomCheck(player, targets[rand.Intn(len(targets))])
+			}
+		}
+	}
+
+	for _, player := range idle {
+		room.markIdle(player)
+	}
+}
+
+// randomCheck 预言家超时后代为查验，结果照常记入私有信息
+// 调用方需持有 room.mu
+func (room *GameRoom) randomCheck(seer *pb.Player, targetID string) {
+	target := room.Players[targetID]
+	seerResult := &pb.SeerResult{
+		TargetId: targetID,
+		Camp:     target.Camp,
+		Day:      int32(room.DayCount),
+	}
+	room.NightActions[seer.PlayerId] = &pb.NightAction{
+		PlayerId:   seer.PlayerId,
+		Role:       seer.Role,
+		TargetId:   targetID,
+		ActionType: pb.ActionType_ACTION_CHECK.LegacyName(),
+		Action:     pb.ActionType_ACTION_CHECK,
+		Timestamp:  time.Now().Unix(),
+	}
+	room.recordNightResult(seer, pb.ActionType_ACTION_CHECK, targetID, seerResult)
+	room.recordAction(seer.PlayerId, pb.ActionType_ACTION_CHECK, targetID)
+	log.Printf("房间 %s: 预言家超时，随机查验 %s", room.ID, targetID)
+}
+
+// markIdle 累计超时次数，达到阈值时标记挂机并按策略交给机器人托管
+func (room *GameRoom) markIdle(player *pb.Player) {
+	room.MissedTurns[player.PlayerId]++
+	if player.IsAfk || room.MissedTurns[player.PlayerId] < room.afkThreshold() {
+		return
+	}
+
+	player.IsAfk = true
+	message := fmt.Sprintf("%s 连续 %d 次超时未行动，已被标记为挂机", room.playerLabel(player.PlayerId), room.MissedTurns[player.PlayerId])
+	if room.TimeoutPolicy.GetBotTakeover() {
+		player.BotControlled = true
+		message += "，由机器人托管"
+	}
+
+	room.broadcastEvent(&pb.GameEvent{
+		EventType:       pb.GameEvent_EVENT_PLAYER_AFK,
+		Message:         message,
+		PhaseInfo:       room.getCurrentPhaseInfo(),
+		AffectedPlayers: []*pb.Player{player},
+		Timestamp:       time.Now().Unix(),
+	})
+}
+
+// markActive 玩家主动行动，清除超时计数和挂机状态
+func (room *GameRoom) markActive(player *pb.Player) {
+	delete(room.MissedTurns, player.PlayerId)
+	player.IsAfk = false
+	player.BotControlled = false
+}
+
+func (room *GameRoom) afkThreshold() int {
+	if t := room.TimeoutPolicy.GetAfkThreshold(); t > 0 {
+		return int(t)
+	}
+	return defaultAFKThreshold
+}
+
+// scheduleBotAction 托管座位在延迟后自动行动，阶段已切换时放弃
+// 调用方需持有 room.mu
+func (room *GameRoom) scheduleBotAction(player *pb.Player) {
+	seq := room.phaseSeq
+	time.AfterFunc(botActionDelay, func() {
+		room.mu.Lock()
+		defer room.mu.Unlock()
+
+		if room.phaseSeq != seq || !player.BotControlled {
+			return
+		}
+		room.botAct(player)
+	})
+}
+
+// botAct 机器人行动：能跳过就跳过，否则随机选择合法目标（狼人不刀队友）
+func (room *GameRoom) botAct(player *pb.Player) {
+	actions := room.availableActions(player)
+	if len(actions) == 0 {
+		return
+	}
+
+	action := actions[0]
+	for _, a := range actions {
+		if a.Action == pb.ActionType_ACTION_SKIP {
+			action = a
+		}
+	}
+
+	targetID := ""
+	if action.RequiresTarget {
+		targets := action.TargetIds
+		if player.Role == pb.Role_WEREWOLF {
+			if filtered := room.nonWerewolfTargets(targets); len(filtered) > 0 {
+				targets = filtered
+			}
+		}
+		if len(targets) == 0 {
+			return
+		}
+		targetID = targets[rand.Intn(len(targets))]
+	}
+
+	switch action.Action {
+	case pb.ActionType_ACTION_VOTE, pb.ActionType_ACTION_ABSTAIN:
+		room.vote(&pb.VoteRequest{
+			RoomId:   room.ID,
+			VoterId:  player.PlayerId,
+			TargetId: targetID,
+			Abstain:  action.Action == pb.ActionType_ACTION_ABSTAIN,
+		})
+		return
+	}
+	room.nightAction(&pb.NightActionRequest{
+		RoomId:         room.ID,
+		PlayerId:       player.PlayerId,
+		TargetPlayerId: targetID,
+		Action:         action.Action,
+	})
+}
+
+// nonWerewolfTargets 过滤掉狼人
+func (room *GameRoom) nonWerewolfTargets(ids []string) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if p, ok := room.Players[id]; ok && p.Role != pb.Role_WEREWOLF {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// werewolfConsensus 统计狼人提名，得票最多的目标为击杀目标，平票时在并列目标中随机选择
+func (room *GameRoom) werewolfConsensus() string {
+	counts := make(map[string]int)
+	for _, target := range room.WerewolfVotes {
+		counts[target]++
+	}
+
+	maxCount := 0
+	var candidates []string
+	for _, id := range room.alivePlayerIDs("") {
+		switch {
+		case counts[id] > maxCount:
+			maxCount = counts[id]
+			candidates = []string{id}
+		case counts[id] == maxCount && maxCount > 0:
+			candidates = append(candidates, id)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[rand.Intn(len(candidates))]
+}
@@ -0,0 +1,177 @@
+package werewolf
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"liam/internal/models"
+	pb "liam/pkg/werewolf/v1"
+)
+
+// 归档写入参数
+const (
+	archiveQueueSize   = 100
+	archiveMaxAttempts = 5
+	archiveTimeout     = 10 * time.Second
+)
+
+// archiveRetryBackoff 首次重试等待时间，之后每次翻倍
+var archiveRetryBackoff = time.Second
+
+// GameStore 对局归档存储，写入对局时同时累加参与者的战绩
+type GameStore interface {
+	SaveGame(ctx context.Context, game *models.Game) error
+}
+
+// Leaderboard 排行榜，对局归档成功后更新
+type Leaderboard interface {
+	RecordGame(ctx context.Context, game *models.Game) error
+}
+
+// archiveWriter 异步写入已结束的对局，失败时按指数退避重试，游戏循环不会被数据库阻塞
+type archiveWriter struct {
+	store       GameStore
+	leaderboard Leaderboard // 为 nil 时不更新排行榜
+	queue       chan *pb.GameReport
+}
+
+func newArchiveWriter(store GameStore, leaderboard Leaderboard) *archiveWriter {
+	w := &archiveWriter{
+		store:       store,
+		leaderboard: leaderboard,
+		queue:       make(chan *pb.GameReport, archiveQueueSize),
+	}
+	go w.run()
+	return w
+}
+
+// SetGameStore 开启对局归档，之后创建的房间在游戏结束时写入 store 并更新 leaderboard
+func (s *WerewolfServer) SetGameStore(store GameStore, leaderboard Leaderboard) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.archiver = newArchiveWriter(store, leaderboard)
+}
+
+// enqueue 提交待归档的对局，队列满时丢弃并记录日志
+func (w *archiveWriter) enqueue(report *pb.GameReport) {
+	select {
+	case w.queue <- report:
+	default:
+		log.Printf("archive queue full, dropping game %s", report.RoomId)
+	}
+}
+
+func (w *archiveWriter) run() {
+	for report := range w.queue {
+		w.save(archiveRecord(report))
+	}
+}
+
+// save 写入对局，成功后再更新排行榜；排行榜单独重试，避免重复写入对局
+func (w *archiveWriter) save(game *models.Game) {
+	if !retry("archive game "+game.RoomID, func(ctx context.Context) error {
+		return w.store.SaveGame(ctx, game)
+	}) {
+		return
+	}
+
+	if w.leaderboard != nil {
+		retry("update leaderboard for game "+game.RoomID, func(ctx context.Context) error {
+			return w.leaderboard.RecordGame(ctx, game)
+		})
+	}
+}
+
+// retry 按指数退避重试 fn，最多 archiveMaxAttempts 次，返回是否成功
+func retry(name string, fn func(ctx context.Context) error) bool {
+	backoff := archiveRetryBackoff
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), archiveTimeout)
+		err := fn(ctx)
+		cancel()
+		if err == nil {
+			return true
+		}
+		if attempt >= archiveMaxAttempts {
+			log.Printf("Failed to %s after %d attempts: %v", name, attempt, err)
+			return false
+		}
+		log.Printf("Failed to %s (attempt %d), retrying in %s: %v", name, attempt, backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// archiveRecord 将复盘报告转换为归档模型
+func archiveRecord(report *pb.GameReport) *models.Game {
+	game := &models.Game{
+		RoomID:       report.RoomId,
+		RoomName:     report.RoomName,
+		Winner:       report.Winner.String(),
+		DayCount:     int(report.DayCount),
+		PlayerCount:  len(report.Players),
+		StartedAt:    time.Unix(report.StartedAt, 0),
+		FinishedAt:   time.Unix(report.FinishedAt, 0),
+		Participants: make([]models.GameParticipant, 0, len(report.Players)),
+		Events:       make([]models.GameEvent, 0, len(report.Timeline)),
+	}
+
+	roles := make(map[string]pb.Role, len(report.Players))
+	for _, reveal := range report.Players {
+		roles[reveal.Player.PlayerId] = reveal.Player.Role
+	}
+	// 预言家查验出狼人、女巫使用解药都计入关键行动，解药只能用在当晚死亡的玩家身上，每次都算成功
+	checks, saves := make(map[string]int), make(map[string]int)
+	for _, entry := range report.Timeline {
+		switch entry.Action {
+		case pb.ActionType_ACTION_CHECK:
+			if roles[entry.TargetId] == pb.Role_WEREWOLF {
+				checks[entry.ActorId]++
+			}
+		case pb.ActionType_ACTION_SAVE:
+			saves[entry.ActorId]++
+		}
+	}
+
+	for _, reveal := range report.Players {
+		player := reveal.Player
+		participant := models.GameParticipant{
+			PlayerID: player.PlayerId,
+			Name:     player.Name,
+			Position: int(player.Position),
+			Role:     player.Role.String(),
+			Camp:     player.Camp.String(),
+			Won:      player.Camp == report.Winner,
+			Survived: player.IsAlive,
+			DeathDay: int(reveal.DeathDay),
+
+			CorrectChecks:   checks[player.PlayerId],
+			SuccessfulSaves: saves[player.PlayerId],
+		}
+		if reveal.DeathCause != pb.DeathCause_DEATH_CAUSE_NONE {
+			participant.DeathCause = reveal.DeathCause.String()
+		}
+		game.Participants = append(game.Participants, participant)
+	}
+
+	for _, entry := range report.Timeline {
+		event := models.GameEvent{
+			Day:         int(entry.Day),
+			Phase:       entry.Phase.String(),
+			ActorID:     entry.ActorId,
+			TargetID:    entry.TargetId,
+			Description: entry.Description,
+			OccurredAt:  time.Unix(entry.Timestamp, 0),
+		}
+		if entry.Action != pb.ActionType_ACTION_UNKNOWN {
+			event.Action = entry.Action.LegacyName()
+		}
+		if entry.DeathCause != pb.DeathCause_DEATH_CAUSE_NONE {
+			event.DeathCause = entry.DeathCause.String()
+		}
+		game.Events = append(game.Events, event)
+	}
+
+	return game
+}
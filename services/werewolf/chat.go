@@ -0,0 +1,284 @@
+package werewolf
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pb "liam/pkg/werewolf/v1"
+)
+
+// 聊天限制
+const (
+	maxChatLength  = 200 // 单条消息最大字数
+	chatRateLimit  = 5   // chatRateWindow 内最多发送的消息数
+	chatRateWindow = 10 * time.Second
+)
+
+// speechDuration 严格发言模式下每位玩家的发言时长，也用作遗言时长
+var speechDuration = 30 * time.Second
+
+// SendChatMessage 发送聊天消息
+func (s *WerewolfServer) SendChatMessage(ctx context.Context, req *pb.SendChatMessageRequest) (*pb.SendChatMessageResponse, error) {
+	sess, err := s.unarySession(ctx, req.RoomId, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+	return sess.chat(req), nil
+}
+
+// sendChat 校验并投递聊天消息
+// 调用方需持有 room.mu
+func (room *GameRoom) sendChat(req *pb.SendChatMessageRequest) *pb.SendChatMessageResponse {
+	content := strings.TrimSpace(req.Content)
+	if content == "" {
+		return &pb.SendChatMessageResponse{
+			Success: false,
+			Message: "消息不能为空",
+			Reason:  pb.ErrorReason_ERROR_REASON_INVALID_MESSAGE,
+		}
+	}
+	if utf8.RuneCountInString(content) > maxChatLength {
+		return &pb.SendChatMessageResponse{
+			Success: false,
+			Message: fmt.Sprintf("消息不能超过 %d 个字", maxChatLength),
+			Reason:  pb.ErrorReason_ERROR_REASON_INVALID_MESSAGE,
+		}
+	}
+
+	if err := room.checkChatPermission(req.PlayerId, req.Channel); err != nil {
+		return &pb.SendChatMessageResponse{
+			Success: false,
+			Message: err.Error(),
+			Reason:  rejectionReason(err),
+		}
+	}
+
+	now := time.Now()
+	if !room.allowChat(req.PlayerId, now) {
+		return &pb.SendChatMessageResponse{
+			Success: false,
+			Message: "发言太频繁，请稍后再试",
+			Reason:  pb.ErrorReason_ERROR_REASON_RATE_LIMITED,
+		}
+	}
+
+	senderName := req.PlayerId
+	if player, ok := room.Players[req.PlayerId]; ok {
+		senderName = player.Name
+	}
+
+	event := &pb.GameEvent{
+		EventType: pb.GameEvent_EVENT_CHAT,
+		Message:   content,
+		Timestamp: now.Unix(),
+		Payload: &pb.GameEvent_Chat{Chat: &pb.ChatMessage{
+			SenderId:   req.PlayerId,
+			SenderName: senderName,
+			Channel:    req.Channel,
+			Content:    content,
+			Timestamp:  now.Unix(),
+		}},
+	}
+	room.publish(event, func(viewerID string) bool {
+		return room.canReadChannel(viewerID, req.Channel)
+	})
+
+	return &pb.SendChatMessageResponse{
+		Success: true,
+		Message: "发送成功",
+	}
+}
+
+// checkChatPermission 按游戏状态判断发送者能否在该频道发言
+// 调用方需持有 room.mu
+func (room *GameRoom) checkChatPermission(senderID string, channel pb.ChatChannel) error {
+	player, seated := room.Players[senderID]
+
+	switch channel {
+	case pb.ChatChannel_CHAT_CHANNEL_PUBLIC:
+		if !seated {
+			return reject(pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED, "观战者只能在观战频道发言")
+		}
+		if room.State == pb.GameState_WAITING || room.State == pb.GameState_FINISHED {
+			return nil
+		}
+		// 当前发言者，包括发表遗言的出局玩家
+		if room.CurrentSpeaker == senderID {
+			return nil
+		}
+		if room.State != pb.GameState_DAY {
+			return reject(pb.ErrorReason_ERROR_REASON_WRONG_PHASE, "夜晚不能在公共频道发言")
+		}
+		if !player.IsAlive {
+			return reject(pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED, "死亡玩家只能在死亡频道发言")
+		}
+		if room.StrictSpeaking {
+			return reject(pb.ErrorReason_ERROR_REASON_NOT_YOUR_TURN, "还没有轮到你发言")
+		}
+		return nil
+
+	case pb.ChatChannel_CHAT_CHANNEL_WEREWOLF:
+		if !seated || !player.IsAlive || player.Role != pb.Role_WEREWOLF {
+			return reject(pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED, "只有存活的狼人可以在狼人频道发言")
+		}
+		if room.State != pb.GameState_NIGHT {
+			return reject(pb.ErrorReason_ERROR_REASON_WRONG_PHASE, "狼人频道只在夜晚开放")
+		}
+		return nil
+
+	case pb.ChatChannel_CHAT_CHANNEL_DEAD:
+		if !seated || player.IsAlive {
+			return reject(pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED, "只有死亡玩家可以在死亡频道发言")
+		}
+		return nil
+
+	case pb.ChatChannel_CHAT_CHANNEL_SPECTATOR:
+		if _, watching := room.Spectators[senderID]; seated || !watching {
+			return reject(pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED, "只有观战者可以在观战频道发言")
+		}
+		return nil
+	}
+
+	return reject(pb.ErrorReason_ERROR_REASON_INVALID_MESSAGE, "未知的聊天频道")
+}
+
+// canReadChannel 判断 viewer 能否实时收到该频道的消息
+// 狼人频道和死亡频道会暴露身份：死亡玩家按房间的上帝视角配置决定能否旁听狼人频道，
+// 观战者只能通过延迟推送看到
+// 调用方需持有 room.mu
+func (room *GameRoom) canReadChannel(viewerID string, channel pb.ChatChannel) bool {
+	viewer, seated := room.Players[viewerID]
+
+	switch channel {
+	case pb.ChatChannel_CHAT_CHANNEL_PUBLIC:
+		return true
+
+	case pb.ChatChannel_CHAT_CHANNEL_WEREWOLF:
+		if !seated {
+			return false
+		}
+		return viewer.Role == pb.Role_WEREWOLF || (!viewer.IsAlive && room.Visibility.GetDeadGodView())
+
+	case pb.ChatChannel_CHAT_CHANNEL_DEAD:
+		return seated && !viewer.IsAlive
+
+	case pb.ChatChannel_CHAT_CHANNEL_SPECTATOR:
+		// 观战频道对存活玩家不可见
+		return !seated || !viewer.IsAlive
+	}
+
+	return false
+}
+
+// allowChat 按滑动窗口限制发言频率
+// 调用方需持有 room.mu
+func (room *GameRoom) allowChat(senderID string, now time.Time) bool {
+	recent := make([]time.Time, 0, chatRateLimit)
+	for _, t := range room.chatTimes[senderID] {
+		if now.Sub(t) < chatRateWindow {
+			recent = append(recent, t)
+		}
+	}
+
+	if len(recent) >= chatRateLimit {
+		room.chatTimes[senderID] = recent
+		return false
+	}
+	room.chatTimes[senderID] = append(recent, now)
+	return true
+}
+
+// startDiscussion 开始白天讨论
+// 自由模式下所有存活玩家都可以发言，讨论持续到阶段时限；严格模式按座位号轮流发言
+// 调用方需持有 room.mu
+func (room *GameRoom) startDiscussion() {
+	if !room.StrictSpeaking {
+		return
+	}
+	room.Speakers = room.alivePlayerIDs("")
+	room.startSpeech(0)
+}
+
+// startSpeech 轮到第 index 位玩家发言，发言时间结束后自动轮到下一位
+// 调用方需持有 room.mu
+func (room *GameRoom) startSpeech(index int) {
+	if index >= len(room.Speakers) {
+		room.CurrentSpeaker = ""
+		room.finishPhase()
+		return
+	}
+
+	speakerID := room.Speakers[index]
+	deadline := time.Now().Add(speechDuration)
+	room.CurrentSpeaker = speakerID
+	room.broadcastEvent(&pb.GameEvent{
+		EventType: pb.GameEvent_EVENT_SPEAKER_CHANGED,
+		Message:   fmt.Sprintf("请 %s 发言", room.playerLabel(speakerID)),
+		PhaseInfo: room.getCurrentPhaseInfo(),
+		Timestamp: time.Now().Unix(),
+		Deadline:  deadline.Unix(),
+		Payload: &pb.GameEvent_SpeakerTurn{SpeakerTurn: &pb.SpeakerTurn{
+			SpeakerId: speakerID,
+			Deadline:  deadline.Unix(),
+		}},
+	})
+
+	// 发言者可能已提前结束发言，此时定时器失效
+	seq := room.phaseSeq
+	room.PhaseTimer = time.AfterFunc(speechDuration, func() {
+		room.mu.Lock()
+		defer room.mu.Unlock()
+
+		if room.phaseSeq == seq && room.CurrentSpeaker == speakerID {
+			room.startSpeech(index + 1)
+		}
+	})
+}
+
+// endSpeech 当前发言者提前结束发言：讨论阶段轮到下一位，遗言阶段直接结束
+// 调用方需持有 room.mu
+func (room *GameRoom) endSpeech(playerID string) *pb.SessionActionResult {
+	if playerID == "" || room.CurrentSpeaker != playerID {
+		return &pb.SessionActionResult{
+			Success: false,
+			Message: "还没有轮到你发言",
+			Reason:  pb.ErrorReason_ERROR_REASON_NOT_YOUR_TURN,
+		}
+	}
+
+	if room.PhaseTimer != nil {
+		room.PhaseTimer.Stop()
+		room.PhaseTimer = nil
+	}
+
+	if room.CurrentPhase == pb.Phase_PHASE_DAY_LAST_WORDS {
+		room.CurrentSpeaker = ""
+		room.finishPhase()
+	} else {
+		for i, id := range room.Speakers {
+			if id == playerID {
+				room.startSpeech(i + 1)
+				break
+			}
+		}
+	}
+
+	return &pb.SessionActionResult{
+		Success: true,
+		Message: "发言结束",
+	}
+}
+
+// phaseTimeLimit 阶段时限，严格发言模式下讨论阶段按发言人数计算
+// 调用方需持有 room.mu
+func (room *GameRoom) phaseTimeLimit(phase pb.Phase) time.Duration {
+	if phase == pb.Phase_PHASE_DAY_DISCUSSION && room.StrictSpeaking {
+		if speakers := len(room.alivePlayerIDs("")); speakers > 0 {
+			return time.Duration(speakers) * speechDuration
+		}
+	}
+	return phaseTimeout
+}
@@ -0,0 +1,57 @@
+package werewolf
+
+import (
+	"errors"
+
+	pb "liam/pkg/werewolf/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errRoomNotFound   = reasonStatus(codes.NotFound, pb.ErrorReason_ERROR_REASON_ROOM_NOT_FOUND, "房间不存在")
+	errPlayerNotFound = reasonStatus(codes.NotFound, pb.ErrorReason_ERROR_REASON_PLAYER_NOT_FOUND, "玩家不存在")
+)
+
+// reasonStatus 构造附带错误原因的 gRPC 错误，同一状态码下网关可按原因区分，如房间不存在和玩家不存在
+func reasonStatus(code codes.Code, reason pb.ErrorReason, message string) error {
+	st, err := status.New(code, message).WithDetails(&pb.ErrorDetail{Reason: reason})
+	if err != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
+
+// statusReason 取出 gRPC 错误附带的错误原因
+func statusReason(err error) pb.ErrorReason {
+	for _, detail := range status.Convert(err).Details() {
+		if d, ok := detail.(*pb.ErrorDetail); ok {
+			return d.Reason
+		}
+	}
+	return pb.ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+// rejection 业务校验失败，作为 success=false 的响应返回，而不是 gRPC 错误
+type rejection struct {
+	reason  pb.ErrorReason
+	message string
+}
+
+func (r *rejection) Error() string {
+	return r.message
+}
+
+func reject(reason pb.ErrorReason, message string) error {
+	return &rejection{reason: reason, message: message}
+}
+
+// rejectionReason 取出校验失败的原因，其他错误返回 UNSPECIFIED
+func rejectionReason(err error) pb.ErrorReason {
+	var r *rejection
+	if errors.As(err, &r) {
+		return r.reason
+	}
+	return pb.ErrorReason_ERROR_REASON_UNSPECIFIED
+}
@@ -0,0 +1,91 @@
+package werewolf
+
+import (
+	pb "liam/pkg/werewolf/v1"
+)
+
+// maxEventLog 房间保留的最近事件数，用于断线补发
+const maxEventLog = 500
+
+// loggedEvent 事件日志条目，保存未经可见性过滤的原始事件
+type loggedEvent struct {
+	event     *pb.GameEvent
+	visibleTo func(viewerID string) bool // 为 nil 时所有人可见
+	phaseSeq  int                        // 事件所在阶段，用于观战者的延迟推送
+}
+
+// publish 为事件分配序号并写入事件日志，然后投递给可见的订阅者
+// 调用方需持有 room.mu
+func (room *GameRoom) publish(event *pb.GameEvent, visibleTo func(viewerID string) bool) {
+	room.eventSeq++
+	event.Seq = room.eventSeq
+
+	room.eventLog = append(room.eventLog, loggedEvent{event: event, visibleTo: visibleTo, phaseSeq: room.phaseSeq})
+	if len(room.eventLog) > maxEventLog {
+		room.eventLog = room.eventLog[len(room.eventLog)-maxEventLog:]
+	}
+
+	for viewerID, ch := range room.Subscribers {
+		if visibleTo != nil && !visibleTo(viewerID) {
+			continue
+		}
+		if room.isDelayedViewer(viewerID) {
+			continue
+		}
+		select {
+		case ch <- room.projectEvent(viewerID, event):
+		default:
+			// 通道满了，跳过，客户端可通过序号补发
+		}
+	}
+	room.publishToFeeds(event, visibleTo)
+}
+
+// eventsSince 返回 viewer 可见的、序号大于 seq 的历史事件
+// 调用方需持有 room.mu
+func (room *GameRoom) eventsSince(viewerID string, seq int64) []*pb.GameEvent {
+	// 上帝视角观战者从该位置重新走延迟推送
+	if spectator := room.spectatorFor(viewerID); spectator != nil && spectator.GodView {
+		if seq < spectator.deliveredSeq {
+			spectator.deliveredSeq = seq
+		}
+		return nil
+	}
+
+	events := make([]*pb.GameEvent, 0)
+	for _, logged := range room.eventLog {
+		if logged.event.Seq <= seq {
+			continue
+		}
+		if logged.visibleTo != nil && !logged.visibleTo(viewerID) {
+			continue
+		}
+		events = append(events, room.projectEvent(viewerID, logged.event))
+	}
+	return events
+}
+
+// ackEvents 记录 viewer 已处理到的事件序号
+// 调用方需持有 room.mu
+func (room *GameRoom) ackEvents(viewerID string, seq int64) {
+	if seq > room.eventAcks[viewerID] && seq <= room.eventSeq {
+		room.eventAcks[viewerID] = seq
+	}
+}
+
+// subscribe 注册事件订阅，同一 viewer 重复订阅时新的通道替换旧的
+// 调用方需持有 room.mu
+func (room *GameRoom) subscribe(viewerID string) chan *pb.GameEvent {
+	ch := make(chan *pb.GameEvent, 100)
+	room.Subscribers[viewerID] = ch
+	return ch
+}
+
+// unsubscribe 取消订阅并关闭通道，通道已被新的订阅替换时保留新的订阅
+// 调用方需持有 room.mu
+func (room *GameRoom) unsubscribe(viewerID string, ch chan *pb.GameEvent) {
+	if room.Subscribers[viewerID] == ch {
+		delete(room.Subscribers, viewerID)
+	}
+	close(ch)
+}
@@ -0,0 +1,29 @@
+package werewolf
+
+import (
+	"context"
+
+	"liam/pkg/identity"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkIdentity 校验请求中的玩家ID与网关签名的身份一致
+// 没有签名身份的调用（服务内部调用）不做校验，是否要求签名由拦截器决定
+func checkIdentity(ctx context.Context, playerID string) error {
+	caller, ok := identity.FromContext(ctx)
+	if ok && caller != playerID {
+		return status.Error(codes.PermissionDenied, "玩家身份与座位不匹配")
+	}
+	return nil
+}
+
+// requireGateway 只允许网关或服务内部调用
+func requireGateway(ctx context.Context) error {
+	caller, ok := identity.FromContext(ctx)
+	if ok && caller != identity.GatewayID {
+		return status.Error(codes.PermissionDenied, "只有网关可以订阅整个房间")
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package werewolf
+
+import (
+	"context"
+	"log"
+	"path"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"liam/pkg/identity"
+	pb "liam/pkg/werewolf/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// rateLimitedMethods 按玩家限流的一元方法，查询类请求不限流
+// 按方法名匹配，新旧服务名共用
+var rateLimitedMethods = map[string]bool{
+	"CreateRoom":      true,
+	"JoinRoom":        true,
+	"StartGame":       true,
+	"NightAction":     true,
+	"Vote":            true,
+	"SendChatMessage": true,
+	"StopSpectating":  true,
+}
+
+// ServerOptions 游戏服务的拦截器链，依次为 panic 恢复、请求日志、身份校验和按玩家限流
+// 恢复放在最外层，拦截器自身的 panic 也不会让进程退出
+func (s *WerewolfServer) ServerOptions(signer *identity.Signer) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			recoveryUnaryInterceptor,
+			loggingUnaryInterceptor,
+			signer.UnaryServerInterceptor(),
+			s.rateLimitUnaryInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			recoveryStreamInterceptor,
+			loggingStreamInterceptor,
+			signer.StreamServerInterceptor(),
+		),
+	}
+}
+
+// recovered 记录 panic 和调用栈，返回给客户端的错误不包含内部细节
+func recovered(method string, r interface{}) error {
+	log.Printf("grpc panic method=%s panic=%v\n%s", method, r, debug.Stack())
+	return status.Error(codes.Internal, "游戏服务内部错误")
+}
+
+func recoveryUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			resp, err = nil, recovered(info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func recoveryStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// requestFields 取出请求中的房间和玩家ID，会话流取 join 中的字段
+func requestFields(req interface{}) (roomID, playerID string) {
+	if session, ok := req.(*pb.SessionRequest); ok {
+		if session.GetJoin() == nil {
+			return "", ""
+		}
+		req = session.GetJoin()
+	}
+
+	if r, ok := req.(interface{ GetRoomId() string }); ok {
+		roomID = r.GetRoomId()
+	}
+	switch r := req.(type) {
+	case interface{ GetPlayerId() string }:
+		playerID = r.GetPlayerId()
+	case interface{ GetVoterId() string }:
+		playerID = r.GetVoterId()
+	}
+	return roomID, playerID
+}
+
+// callerID 取出 metadata 中声明的调用方，只用于日志，身份以签名校验的结果为准
+func callerID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(identity.MetadataPlayerID); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func logRequest(method, roomID, playerID, caller string, start time.Time, err error) {
+	st := status.Convert(err)
+	if err != nil {
+		log.Printf("grpc method=%s room=%s player=%s caller=%s code=%s duration=%s error=%q",
+			method, roomID, playerID, caller, st.Code(), time.Since(start), st.Message())
+		return
+	}
+	log.Printf("grpc method=%s room=%s player=%s caller=%s code=%s duration=%s",
+		method, roomID, playerID, caller, st.Code(), time.Since(start))
+}
+
+func loggingUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	roomID, playerID := requestFields(req)
+	logRequest(info.FullMethod, roomID, playerID, callerID(ctx), start, err)
+	return resp, err
+}
+
+// loggingStreamInterceptor 在流结束时记录一条日志，房间和玩家取自客户端发送的第一条带有这些字段的消息
+func loggingStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	stream := &loggedStream{ServerStream: ss}
+	err := handler(srv, stream)
+	if status.Code(err) == codes.Canceled {
+		// 客户端断开是流的正常结束
+		err = nil
+	}
+
+	stream.mu.Lock()
+	roomID, playerID := stream.roomID, stream.playerID
+	stream.mu.Unlock()
+	logRequest(info.FullMethod, roomID, playerID, callerID(ss.Context()), start, err)
+	return err
+}
+
+// loggedStream 记录流中收到的房间和玩家ID，会话流在独立的协程中接收消息
+type loggedStream struct {
+	grpc.ServerStream
+
+	mu       sync.Mutex
+	roomID   string
+	playerID string
+}
+
+func (s *loggedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	roomID, playerID := requestFields(m)
+	s.mu.Lock()
+	if s.roomID == "" && roomID != "" {
+		s.roomID = roomID
+	}
+	if s.playerID == "" && playerID != "" {
+		s.playerID = playerID
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+// rateLimitUnaryInterceptor 按签名身份限流，没有签名身份的内部调用按请求中的玩家ID，网关自身的调用不限流
+func (s *WerewolfServer) rateLimitUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !rateLimitedMethods[path.Base(info.FullMethod)] {
+		return handler(ctx, req)
+	}
+
+	playerID, ok := identity.FromContext(ctx)
+	if !ok {
+		_, playerID = requestFields(req)
+	}
+	if playerID != identity.GatewayID && !s.allowRequest(playerID) {
+		return nil, errRateLimited
+	}
+	return handler(ctx, req)
+}
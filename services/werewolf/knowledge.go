@@ -0,0 +1,73 @@
+package werewolf
+
+import (
+	"fmt"
+	"time"
+
+	pb "liam/pkg/werewolf/v1"
+)
+
+// knowledgeOf 返回玩家的私有信息，不存在时创建
+// 调用方需持有 room.mu
+func (room *GameRoom) knowledgeOf(playerID string) *pb.PrivateKnowledge {
+	k, ok := room.Knowledge[playerID]
+	if !ok {
+		k = &pb.PrivateKnowledge{}
+		room.Knowledge[playerID] = k
+	}
+	return k
+}
+
+// recordNightResult 保存夜晚行动结果并私下推送给行动者
+// 调用方需持有 room.mu
+func (room *GameRoom) recordNightResult(player *pb.Player, action pb.ActionType, targetID string, seerResult *pb.SeerResult) {
+	day := int32(room.DayCount)
+	event := &pb.GameEvent{
+		EventType: pb.GameEvent_EVENT_NIGHT_RESULT,
+		PhaseInfo: room.getCurrentPhaseInfo(),
+		Timestamp: time.Now().Unix(),
+	}
+
+	switch action {
+	case pb.ActionType_ACTION_GUARD:
+		record := &pb.GuardRecord{Day: day, TargetId: targetID}
+		k := room.knowledgeOf(player.PlayerId)
+		k.GuardRecords = append(k.GuardRecords, record)
+		event.Message = fmt.Sprintf("第 %d 晚你守护了 %s", day, room.playerLabel(targetID))
+		event.Payload = &pb.GameEvent_GuardRecord{GuardRecord: record}
+
+	case pb.ActionType_ACTION_SAVE, pb.ActionType_ACTION_POISON:
+		record := &pb.WitchPotionRecord{Day: day, Action: action, TargetId: targetID}
+		k := room.knowledgeOf(player.PlayerId)
+		k.WitchPotions = append(k.WitchPotions, record)
+		if action == pb.ActionType_ACTION_SAVE {
+			event.Message = fmt.Sprintf("第 %d 晚你对 %s 使用了解药", day, room.playerLabel(targetID))
+		} else {
+			event.Message = fmt.Sprintf("第 %d 晚你对 %s 使用了毒药", day, room.playerLabel(targetID))
+		}
+		event.Payload = &pb.GameEvent_WitchPotion{WitchPotion: record}
+
+	case pb.ActionType_ACTION_CHECK:
+		k := room.knowledgeOf(player.PlayerId)
+		k.SeerChecks = append(k.SeerChecks, seerResult)
+		event.Message = fmt.Sprintf("第 %d 晚查验 %s：%s阵营", day, room.playerLabel(targetID), getCampName(seerResult.Camp))
+		event.Payload = &pb.GameEvent_SeerResult{SeerResult: seerResult}
+
+	case pb.ActionType_ACTION_KILL:
+		event.Message = fmt.Sprintf("第 %d 晚你选择击杀 %s", day, room.playerLabel(targetID))
+
+	default:
+		event.Message = fmt.Sprintf("第 %d 晚你放弃了行动", day)
+	}
+
+	room.sendToPlayer(player.PlayerId, event)
+}
+
+// playerLabel 形如 "张三(3号)"
+func (room *GameRoom) playerLabel(playerID string) string {
+	p, ok := room.Players[playerID]
+	if !ok {
+		return playerID
+	}
+	return fmt.Sprintf("%s(%d号)", p.Name, p.Position)
+}
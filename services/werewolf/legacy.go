@@ -0,0 +1,19 @@
+package werewolf
+
+import (
+	pb "liam/pkg/werewolf/v1"
+
+	"google.golang.org/grpc"
+)
+
+// LegacyServiceName 合并 proto 之前的服务名（未带版本号）
+// 兼容期内同时注册，旧客户端调用 /werewolf.WerewolfService/* 仍可使用；
+// 两个版本的消息字段编号一致，wire 格式兼容
+const LegacyServiceName = "werewolf.WerewolfService"
+
+// RegisterLegacyService 以旧服务名注册同一个服务实现
+func RegisterLegacyService(s grpc.ServiceRegistrar, srv pb.WerewolfServiceServer) {
+	desc := pb.WerewolfService_ServiceDesc
+	desc.ServiceName = LegacyServiceName
+	s.RegisterService(&desc, srv)
+}
@@ -0,0 +1,388 @@
+package werewolf
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"liam/internal/models"
+	pb "liam/pkg/werewolf/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 匹配参数
+const (
+	matchInterval      = 2 * time.Second // 撮合与推送排队状态的间隔
+	baseRatingWindow   = 100             // 初始允许的等级分差
+	ratingWindowGrowth = 50              // 每等待 ratingWindowStep 扩大的分差
+	ratingWindowStep   = 10 * time.Second
+	defaultQueueWait   = 60 * time.Second // 没有历史数据时的预计等待时间
+)
+
+// matchStartDelay 匹配成功到开始游戏的间隔，留给客户端接入房间
+var matchStartDelay = 5 * time.Second
+
+// boardPresets 匹配使用的预设板子
+var boardPresets = map[string]map[string]int32{
+	"standard_6":  {"werewolf": 2, "seer": 1, "witch": 1, "villager": 2},
+	"standard_9":  {"werewolf": 3, "seer": 1, "witch": 1, "guard": 1, "villager": 3},
+	"standard_12": {"werewolf": 4, "seer": 1, "witch": 1, "guard": 1, "hunter": 1, "villager": 4},
+}
+
+// RatingStore 查询玩家等级分
+type RatingStore interface {
+	GetRating(ctx context.Context, playerID string) (int, error)
+}
+
+// queueEntry 排队中的玩家
+type queueEntry struct {
+	playerID   string
+	playerName string
+	board      string
+	rating     int
+	joinedAt   time.Time
+	updates    chan *pb.QueueUpdate // 只保留最新的状态
+	done       chan struct{}        // 排队的流结束时在 matchmaker.mu 下关闭，之后不再放回队列
+}
+
+// notify 推送最新状态，未读取的旧状态直接丢弃
+// 只由持有 matchmaker.mu 的一方调用
+func (e *queueEntry) notify(update *pb.QueueUpdate) {
+	select {
+	case <-e.updates:
+	default:
+	}
+	e.updates <- update
+}
+
+// matchmaker 匹配队列
+type matchmaker struct {
+	queues  map[string][]*queueEntry // board -> 按加入时间排序的队列
+	avgWait map[string]time.Duration // board -> 最近匹配的平均等待时间
+	ratings RatingStore              // 为 nil 时所有玩家按初始分匹配
+	once    sync.Once                // 首次排队时启动撮合协程
+	mu      sync.Mutex
+}
+
+func newMatchmaker() *matchmaker {
+	return &matchmaker{
+		queues:  make(map[string][]*queueEntry),
+		avgWait: make(map[string]time.Duration),
+	}
+}
+
+// SetRatingStore 设置匹配使用的等级分来源
+func (s *WerewolfServer) SetRatingStore(ratings RatingStore) {
+	s.matchmaker.mu.Lock()
+	defer s.matchmaker.mu.Unlock()
+	s.matchmaker.ratings = ratings
+}
+
+// JoinQueue 排队匹配，流保持打开期间推送排队状态，匹配成功后推送房间ID并结束
+func (s *WerewolfServer) JoinQueue(req *pb.JoinQueueRequest, stream pb.WerewolfService_JoinQueueServer) error {
+	if _, ok := boardPresets[req.Board]; !ok {
+		return status.Error(codes.InvalidArgument, "未知的板子")
+	}
+	if req.PlayerId == "" {
+		return status.Error(codes.InvalidArgument, "player_id 不能为空")
+	}
+	if err := checkIdentity(stream.Context(), req.PlayerId); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	mm := s.matchmaker
+
+	entry := &queueEntry{
+		playerID:   req.PlayerId,
+		playerName: req.PlayerName,
+		board:      req.Board,
+		rating:     mm.rating(ctx, req.PlayerId),
+		joinedAt:   time.Now(),
+		updates:    make(chan *pb.QueueUpdate, 1),
+		done:       make(chan struct{}),
+	}
+	if entry.playerName == "" {
+		entry.playerName = req.PlayerId
+	}
+
+	if !mm.add(entry) {
+		return status.Error(codes.AlreadyExists, "已在匹配队列中")
+	}
+	defer mm.remove(entry)
+
+	mm.once.Do(func() { go s.runMatchmaking() })
+	s.matchBoard(req.Board)
+
+	for {
+		select {
+		case update := <-entry.updates:
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+			if update.Status == pb.QueueUpdate_STATUS_MATCHED {
+				return nil
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// runMatchmaking 定期撮合所有队列，并向仍在排队的玩家推送位置和预计等待时间
+func (s *WerewolfServer) runMatchmaking() {
+	ticker := time.NewTicker(matchInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for board := range boardPresets {
+			s.matchBoard(board)
+		}
+	}
+}
+
+// matchBoard 撮合一个板子的队列，直到凑不出一局
+func (s *WerewolfServer) matchBoard(board string) {
+	mm := s.matchmaker
+	seats := boardSeats(board)
+
+	for {
+		mm.mu.Lock()
+		group := findMatch(mm.queues[board], seats, time.Now())
+		if group == nil {
+			mm.notifyWaiting(board)
+			mm.mu.Unlock()
+			return
+		}
+		mm.take(board, group)
+		mm.mu.Unlock()
+
+		roomID, err := s.startMatchedRoom(board, group)
+
+		mm.mu.Lock()
+		if err != nil {
+			log.Printf("Failed to start matched room for %s: %v", board, err)
+			mm.requeue(board, group)
+			mm.notifyWaiting(board)
+			mm.mu.Unlock()
+			return
+		}
+		for _, entry := range group {
+			entry.notify(&pb.QueueUpdate{
+				Status: pb.QueueUpdate_STATUS_MATCHED,
+				Board:  board,
+				Rating: int32(entry.rating),
+				RoomId: roomID,
+			})
+		}
+		mm.mu.Unlock()
+	}
+}
+
+// startMatchedRoom 按预设板子创建房间并入座，matchStartDelay 后开始游戏
+func (s *WerewolfServer) startMatchedRoom(board string, group []*queueEntry) (string, error) {
+	ctx := context.Background()
+	created, err := s.CreateRoom(ctx, &pb.CreateRoomRequest{
+		RoomName:   fmt.Sprintf("匹配房间 %s", board),
+		MaxPlayers: int32(len(group)),
+		RoleConfig: boardPresets[board],
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, entry := range group {
+		joined, err := s.JoinRoom(ctx, &pb.JoinRoomRequest{
+			RoomId:     created.RoomId,
+			PlayerId:   entry.playerID,
+			PlayerName: entry.playerName,
+		})
+		if err == nil && !joined.Success {
+			err = fmt.Errorf("%s: %s", entry.playerID, joined.Message)
+		}
+		if err != nil {
+			// 撤销只坐了一部分玩家的房间，玩家会被放回队列
+			s.mu.Lock()
+			delete(s.rooms, created.RoomId)
+			s.mu.Unlock()
+			return "", err
+		}
+	}
+
+	time.AfterFunc(matchStartDelay, func() {
+		resp, err := s.StartGame(context.Background(), &pb.StartGameRequest{
+			RoomId:   created.RoomId,
+			PlayerId: group[0].playerID,
+		})
+		if err != nil || !resp.Success {
+			log.Printf("Failed to start matched room %s: %v %v", created.RoomId, err, resp.GetMessage())
+		}
+	})
+	return created.RoomId, nil
+}
+
+// findMatch 在队列中找出人数为 seats、等级分差不超过允许范围的一组玩家
+// 允许的分差随组内等待最久的玩家的等待时间扩大；有多组可选时优先包含等待最久玩家的一组
+func findMatch(queue []*queueEntry, seats int, now time.Time) []*queueEntry {
+	if len(queue) < seats {
+		return nil
+	}
+
+	sorted := make([]*queueEntry, len(queue))
+	copy(sorted, queue)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].rating < sorted[j].rating
+	})
+
+	var best []*queueEntry
+	var bestJoined time.Time
+	for i := 0; i+seats <= len(sorted); i++ {
+		group := sorted[i : i+seats]
+		oldest := group[0].joinedAt
+		for _, entry := range group {
+			if entry.joinedAt.Before(oldest) {
+				oldest = entry.joinedAt
+			}
+		}
+		if group[seats-1].rating-group[0].rating > ratingWindow(now.Sub(oldest)) {
+			continue
+		}
+		if best == nil || oldest.Before(bestJoined) {
+			best, bestJoined = group, oldest
+		}
+	}
+	return best
+}
+
+// ratingWindow 等待 waited 后允许的等级分差
+func ratingWindow(waited time.Duration) int {
+	return baseRatingWindow + int(waited/ratingWindowStep)*ratingWindowGrowth
+}
+
+func boardSeats(board string) int {
+	seats := 0
+	for _, count := range boardPresets[board] {
+		seats += int(count)
+	}
+	return seats
+}
+
+// rating 查询玩家等级分，查询失败时按初始分处理
+func (mm *matchmaker) rating(ctx context.Context, playerID string) int {
+	mm.mu.Lock()
+	ratings := mm.ratings
+	mm.mu.Unlock()
+
+	if ratings == nil {
+		return models.DefaultRating
+	}
+	rating, err := ratings.GetRating(ctx, playerID)
+	if err != nil {
+		log.Printf("Failed to get rating for %s: %v", playerID, err)
+		return models.DefaultRating
+	}
+	return rating
+}
+
+// add 加入队列，玩家已在任一队列中时返回 false
+func (mm *matchmaker) add(entry *queueEntry) bool {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+
+	for _, queue := range mm.queues {
+		for _, e := range queue {
+			if e.playerID == entry.playerID {
+				return false
+			}
+		}
+	}
+	mm.queues[entry.board] = append(mm.queues[entry.board], entry)
+	return true
+}
+
+// remove 排队的流结束时退出队列；正在开局的玩家已不在队列中，标记后开局失败也不会被放回
+func (mm *matchmaker) remove(entry *queueEntry) {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+	mm.queues[entry.board] = removeEntries(mm.queues[entry.board], []*queueEntry{entry})
+	close(entry.done)
+}
+
+// take 将匹配成功的玩家移出队列并更新平均等待时间
+// 调用方需持有 mm.mu
+func (mm *matchmaker) take(board string, group []*queueEntry) {
+	mm.queues[board] = removeEntries(mm.queues[board], group)
+
+	now := time.Now()
+	for _, entry := range group {
+		waited := now.Sub(entry.joinedAt)
+		if avg, ok := mm.avgWait[board]; ok {
+			mm.avgWait[board] = (avg*4 + waited) / 5
+		} else {
+			mm.avgWait[board] = waited
+		}
+	}
+}
+
+// requeue 开局失败时把仍在排队的玩家放回队列，保留原来的排队时间
+// 调用方需持有 mm.mu
+func (mm *matchmaker) requeue(board string, group []*queueEntry) {
+	queue := mm.queues[board]
+	for _, entry := range group {
+		select {
+		case <-entry.done:
+			// 开局期间流已结束
+		default:
+			queue = append(queue, entry)
+		}
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		return queue[i].joinedAt.Before(queue[j].joinedAt)
+	})
+	mm.queues[board] = queue
+}
+
+// notifyWaiting 向排队中的玩家推送位置和预计等待时间
+// 调用方需持有 mm.mu
+func (mm *matchmaker) notifyWaiting(board string) {
+	queue := mm.queues[board]
+	avg, ok := mm.avgWait[board]
+	if !ok {
+		avg = defaultQueueWait
+	}
+
+	now := time.Now()
+	for i, entry := range queue {
+		remaining := avg - now.Sub(entry.joinedAt)
+		if remaining < 0 {
+			remaining = 0
+		}
+		entry.notify(&pb.QueueUpdate{
+			Status:               pb.QueueUpdate_STATUS_WAITING,
+			Board:                board,
+			Position:             int32(i + 1),
+			QueueSize:            int32(len(queue)),
+			EstimatedWaitSeconds: int32(remaining.Seconds()),
+			Rating:               int32(entry.rating),
+		})
+	}
+}
+
+func removeEntries(queue []*queueEntry, entries []*queueEntry) []*queueEntry {
+	removed := make(map[*queueEntry]bool, len(entries))
+	for _, entry := range entries {
+		removed[entry] = true
+	}
+	kept := queue[:0]
+	for _, entry := range queue {
+		if !removed[entry] {
+			kept = append(kept, entry)
+		}
+	}
+	return kept
+}
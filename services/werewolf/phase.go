@@ -0,0 +1,51 @@
+package werewolf
+
+import (
+	"math/rand"
+	"time"
+)
+
+// 缺席角色阶段的伪装时长范围，与真实玩家的行动时间分布保持在同一区间
+var (
+	fakePhaseMin = 10 * time.Second
+	fakePhaseMax = phaseTimeout
+)
+
+// finishPhase 通知游戏主循环当前阶段已完成，不会阻塞
+// 调用方需持有 room.mu
+func (room *GameRoom) finishPhase() {
+	select {
+	case room.PhaseDone <- true:
+	default:
+		// 已经通知过
+	}
+}
+
+// finishPhaseAfter 在 d 之后结束当前阶段，阶段已切换时定时器失效
+// 调用方需持有 room.mu
+func (room *GameRoom) finishPhaseAfter(d time.Duration) {
+	seq := room.phaseSeq
+	room.PhaseTimer = time.AfterFunc(d, func() {
+		room.mu.Lock()
+		defer room.mu.Unlock()
+
+		if room.phaseSeq == seq {
+			room.finishPhase()
+		}
+	})
+}
+
+// fakePhase 角色缺席或已死亡时，仍广播同样的提示并随机持续一段时间，
+// 使外部无法通过阶段时长推断该角色的存活情况
+// 调用方需持有 room.mu
+func (room *GameRoom) fakePhase(message string) {
+	room.announcePhase(message)
+	room.finishPhaseAfter(randomFakeDuration())
+}
+
+func randomFakeDuration() time.Duration {
+	if fakePhaseMax <= fakePhaseMin {
+		return fakePhaseMin
+	}
+	return fakePhaseMin + time.Duration(rand.Int63n(int64(fakePhaseMax-fakePhaseMin)))
+}
@@ -0,0 +1,83 @@
+package werewolf
+
+import (
+	"fmt"
+	"time"
+
+	pb "liam/pkg/werewolf/v1"
+)
+
+// reconnectGrace 连接全部断开后保持重连中状态的时间，超时后标记为离线
+var reconnectGrace = 30 * time.Second
+
+// playerConnections 座位玩家接收事件的连接，会话和事件订阅各算一条
+type playerConnections struct {
+	count int
+	seq   int // 每次断开递增，用于丢弃过期的离线定时器
+}
+
+// connect 登记玩家的一条连接，第一条连接建立时标记为在线
+// 调用方需持有 room.mu
+func (room *GameRoom) connect(playerID string) {
+	player, seated := room.Players[playerID]
+	if !seated {
+		return
+	}
+
+	conns := room.connectionsFor(playerID)
+	conns.count++
+	if conns.count == 1 {
+		room.setPresence(player, pb.PresenceStatus_PRESENCE_ONLINE, fmt.Sprintf("%s 已上线", player.Name))
+	}
+}
+
+// disconnect 移除玩家的一条连接，最后一条断开时标记为重连中，超时未重连时标记为离线
+// 调用方需持有 room.mu
+func (room *GameRoom) disconnect(playerID string) {
+	player, seated := room.Players[playerID]
+	conns := room.connections[playerID]
+	if !seated || conns == nil || conns.count == 0 {
+		return
+	}
+
+	conns.count--
+	if conns.count > 0 {
+		return
+	}
+	conns.seq++
+	room.setPresence(player, pb.PresenceStatus_PRESENCE_RECONNECTING, fmt.Sprintf("%s 断线了，等待重连", player.Name))
+
+	seq := conns.seq
+	time.AfterFunc(reconnectGrace, func() {
+		room.mu.Lock()
+		defer room.mu.Unlock()
+
+		if conns.seq == seq && conns.count == 0 {
+			room.setPresence(player, pb.PresenceStatus_PRESENCE_OFFLINE, fmt.Sprintf("%s 已离线", player.Name))
+		}
+	})
+}
+
+// 调用方需持有 room.mu
+func (room *GameRoom) connectionsFor(playerID string) *playerConnections {
+	conns := room.connections[playerID]
+	if conns == nil {
+		conns = &playerConnections{}
+		room.connections[playerID] = conns
+	}
+	return conns
+}
+
+// setPresence 更新玩家的连接状态并广播
+// 调用方需持有 room.mu
+func (room *GameRoom) setPresence(player *pb.Player, presence pb.PresenceStatus, message string) {
+	now := time.Now().Unix()
+	player.Presence = presence
+	player.LastSeen = now
+	room.broadcastEvent(&pb.GameEvent{
+		EventType:       pb.GameEvent_EVENT_PLAYER_PRESENCE,
+		Message:         message,
+		AffectedPlayers: []*pb.Player{player},
+		Timestamp:       now,
+	})
+}
@@ -0,0 +1,106 @@
+package werewolf
+
+import (
+	"sync"
+	"time"
+
+	pb "liam/pkg/werewolf/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+// errRateLimited 玩家请求过于频繁
+var errRateLimited = reasonStatus(codes.ResourceExhausted, pb.ErrorReason_ERROR_REASON_RATE_LIMITED, "操作太频繁，请稍后再试")
+
+// rateLimitSweepInterval 清理空闲令牌桶的间隔
+const rateLimitSweepInterval = time.Minute
+
+// rateLimiter 按玩家的令牌桶限流，防止刷行动、投票和聊天
+// 一元请求和会话流内的请求共用同一个令牌桶
+type rateLimiter struct {
+	rate  float64 // 每秒补充的令牌数
+	burst float64 // 令牌桶容量，即允许的突发请求数
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:      rate,
+		burst:     float64(burst),
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// allow 消耗玩家的一个令牌，令牌不足时返回 false；未配置限流时总是放行
+func (l *rateLimiter) allow(playerID string, now time.Time) bool {
+	if l == nil || l.rate <= 0 || playerID == "" {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) > rateLimitSweepInterval {
+		l.sweep(now)
+	}
+
+	bucket, ok := l.buckets[playerID]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, updated: now}
+		l.buckets[playerID] = bucket
+	}
+	bucket.tokens += now.Sub(bucket.updated).Seconds() * l.rate
+	if bucket.tokens > l.burst {
+		bucket.tokens = l.burst
+	}
+	bucket.updated = now
+
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// sweep 删除已经补满的令牌桶，它们与新建的桶没有区别
+// 调用方需持有 l.mu
+func (l *rateLimiter) sweep(now time.Time) {
+	full := time.Duration(l.burst / l.rate * float64(time.Second))
+	for playerID, bucket := range l.buckets {
+		if now.Sub(bucket.updated) >= full {
+			delete(l.buckets, playerID)
+		}
+	}
+	l.lastSweep = now
+}
+
+// SetRateLimit 按玩家限制行动、投票、聊天等请求的频率，rate 为每秒请求数，为 0 时不限流
+func (s *WerewolfServer) SetRateLimit(rate float64, burst int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rate <= 0 {
+		s.limiter = nil
+		return
+	}
+	s.limiter = newRateLimiter(rate, burst)
+}
+
+// allowRequest 消耗玩家的一个令牌
+func (s *WerewolfServer) allowRequest(playerID string) bool {
+	s.mu.RLock()
+	limiter := s.limiter
+	s.mu.RUnlock()
+	return limiter.allow(playerID, time.Now())
+}
@@ -0,0 +1,47 @@
+package werewolf
+
+import (
+	"fmt"
+	"time"
+
+	pb "liam/pkg/werewolf/v1"
+)
+
+// setReady 切换玩家的准备状态，只在游戏开始前有效
+// 调用方需持有 room.mu
+func (room *GameRoom) setReady(playerID string, ready bool) *pb.SessionActionResult {
+	player, seated := room.Players[playerID]
+	if !seated {
+		return &pb.SessionActionResult{
+			Success: false,
+			Message: "观战者不能准备",
+			Reason:  pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED,
+		}
+	}
+	if room.State != pb.GameState_WAITING {
+		return &pb.SessionActionResult{
+			Success: false,
+			Message: "游戏已经开始",
+			Reason:  pb.ErrorReason_ERROR_REASON_GAME_ALREADY_STARTED,
+		}
+	}
+
+	message := fmt.Sprintf("%s 已准备", player.Name)
+	if !ready {
+		message = fmt.Sprintf("%s 取消了准备", player.Name)
+	}
+	if player.Ready != ready {
+		player.Ready = ready
+		room.broadcastEvent(&pb.GameEvent{
+			EventType:       pb.GameEvent_EVENT_PLAYER_READY,
+			Message:         message,
+			AffectedPlayers: []*pb.Player{player},
+			Timestamp:       time.Now().Unix(),
+		})
+	}
+
+	return &pb.SessionActionResult{
+		Success: true,
+		Message: message,
+	}
+}
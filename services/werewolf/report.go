@@ -0,0 +1,128 @@
+package werewolf
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "liam/pkg/werewolf/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// deathRecord 玩家的死亡原因和天数
+type deathRecord struct {
+	cause pb.DeathCause
+	day   int32
+}
+
+// GetGameReport 获取复盘报告，游戏结束后可用
+func (s *WerewolfServer) GetGameReport(ctx context.Context, req *pb.GetGameReportRequest) (*pb.GetGameReportResponse, error) {
+	s.mu.RLock()
+	room, exists := s.rooms[req.RoomId]
+	s.mu.RUnlock()
+
+	if !exists {
+		return nil, errRoomNotFound
+	}
+
+	room.mu.RLock()
+	defer room.mu.RUnlock()
+
+	if room.Report == nil {
+		return nil, status.Error(codes.FailedPrecondition, "游戏尚未结束")
+	}
+
+	return &pb.GetGameReportResponse{Report: room.Report}, nil
+}
+
+// recordAction 将夜晚行动或投票记入时间线
+// 调用方需持有 room.mu
+func (room *GameRoom) recordAction(actorID string, action pb.ActionType, targetID string) {
+	actor, target := room.playerLabel(actorID), room.playerLabel(targetID)
+
+	var description string
+	switch action {
+	case pb.ActionType_ACTION_GUARD:
+		description = fmt.Sprintf("%s 守护了 %s", actor, target)
+	case pb.ActionType_ACTION_KILL:
+		description = fmt.Sprintf("%s 选择击杀 %s", actor, target)
+	case pb.ActionType_ACTION_SAVE:
+		description = fmt.Sprintf("%s 对 %s 使用了解药", actor, target)
+	case pb.ActionType_ACTION_POISON:
+		description = fmt.Sprintf("%s 对 %s 使用了毒药", actor, target)
+	case pb.ActionType_ACTION_CHECK:
+		description = fmt.Sprintf("%s 查验了 %s", actor, target)
+	case pb.ActionType_ACTION_VOTE:
+		description = fmt.Sprintf("%s 投票给 %s", actor, target)
+	case pb.ActionType_ACTION_ABSTAIN:
+		description = fmt.Sprintf("%s 弃票", actor)
+	default:
+		description = fmt.Sprintf("%s 放弃了行动", actor)
+	}
+
+	room.Timeline = append(room.Timeline, &pb.TimelineEntry{
+		Day:         int32(room.DayCount),
+		Phase:       room.CurrentPhase,
+		ActorId:     actorID,
+		Action:      action,
+		TargetId:    targetID,
+		Description: description,
+		Timestamp:   time.Now().Unix(),
+	})
+}
+
+// recordDeath 记录玩家死亡并记入时间线
+// 调用方需持有 room.mu
+func (room *GameRoom) recordDeath(player *pb.Player, cause pb.DeathCause) {
+	day := int32(room.DayCount)
+	room.Deaths[player.PlayerId] = deathRecord{cause: cause, day: day}
+
+	label := room.playerLabel(player.PlayerId)
+	var description string
+	switch cause {
+	case pb.DeathCause_DEATH_CAUSE_WEREWOLF:
+		description = fmt.Sprintf("%s 被狼人击杀", label)
+	case pb.DeathCause_DEATH_CAUSE_POISON:
+		description = fmt.Sprintf("%s 被女巫毒杀", label)
+	case pb.DeathCause_DEATH_CAUSE_VOTE:
+		description = fmt.Sprintf("%s 被投票放逐", label)
+	}
+
+	room.Timeline = append(room.Timeline, &pb.TimelineEntry{
+		Day:         day,
+		Phase:       room.CurrentPhase,
+		TargetId:    player.PlayerId,
+		DeathCause:  cause,
+		Description: description,
+		Timestamp:   time.Now().Unix(),
+	})
+}
+
+// buildReport 生成复盘报告，公开所有玩家的身份、死因和完整时间线
+// 调用方需持有 room.mu
+func (room *GameRoom) buildReport(winner pb.Camp) *pb.GameReport {
+	report := &pb.GameReport{
+		RoomId:     room.ID,
+		RoomName:   room.Name,
+		Winner:     winner,
+		DayCount:   int32(room.DayCount),
+		StartedAt:  room.StartedAt.Unix(),
+		FinishedAt: time.Now().Unix(),
+		Timeline:   room.Timeline,
+		Votes:      room.VoteHistory,
+	}
+
+	for _, player := range room.sortedPlayers() {
+		death := room.Deaths[player.PlayerId]
+		report.Players = append(report.Players, &pb.PlayerReveal{
+			Player:     proto.Clone(player).(*pb.Player),
+			DeathCause: death.cause,
+			DeathDay:   death.day,
+		})
+	}
+
+	return report
+}
@@ -0,0 +1,147 @@
+package werewolf
+
+import (
+	"context"
+	"log"
+
+	pb "liam/pkg/werewolf/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// roomFeed 网关的房间级订阅，每个事件按可见性分组后投递一次，由网关分发给本地连接
+type roomFeed struct {
+	events chan *pb.RoomEvent
+}
+
+// SubscribeRoom 订阅整个房间的事件，只允许网关调用；网关重新订阅时带上最后收到的序号
+func (s *WerewolfServer) SubscribeRoom(req *pb.SubscribeRoomRequest, stream pb.WerewolfService_SubscribeRoomServer) error {
+	if err := requireGateway(stream.Context()); err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	room, exists := s.rooms[req.RoomId]
+	s.mu.RUnlock()
+	if !exists {
+		return errRoomNotFound
+	}
+
+	// 补发与登记在同一把锁内完成，之后的事件只会从通道收到
+	feed := &roomFeed{events: make(chan *pb.RoomEvent, 256)}
+	var backlog []*pb.RoomEvent
+	room.mu.Lock()
+	if req.LastSeq > 0 {
+		for _, logged := range room.eventLog {
+			if logged.event.Seq > req.LastSeq {
+				backlog = append(backlog, room.feedDeliveries(logged.event, logged.visibleTo)...)
+			}
+		}
+	}
+	room.feeds[feed] = struct{}{}
+	room.mu.Unlock()
+
+	defer func() {
+		room.mu.Lock()
+		delete(room.feeds, feed)
+		room.mu.Unlock()
+	}()
+
+	for _, event := range backlog {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case event := <-feed.events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// StopSpectating 观战者离开房间
+func (s *WerewolfServer) StopSpectating(ctx context.Context, req *pb.StopSpectatingRequest) (*pb.StopSpectatingResponse, error) {
+	if err := checkIdentity(ctx, req.PlayerId); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	room, exists := s.rooms[req.RoomId]
+	s.mu.RUnlock()
+	if !exists {
+		return nil, errRoomNotFound
+	}
+
+	room.mu.Lock()
+	defer room.mu.Unlock()
+
+	if _, ok := room.Spectators[req.PlayerId]; !ok {
+		return &pb.StopSpectatingResponse{
+			Success: false,
+			Message: "没有在观战",
+			Reason:  pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED,
+		}, nil
+	}
+	delete(room.Spectators, req.PlayerId)
+
+	return &pb.StopSpectatingResponse{
+		Success: true,
+		Message: "已离开观战",
+	}, nil
+}
+
+// publishToFeeds 将事件按接收者视角分组后投递给房间订阅
+// 调用方需持有 room.mu
+func (room *GameRoom) publishToFeeds(event *pb.GameEvent, visibleTo func(viewerID string) bool) {
+	if len(room.feeds) == 0 {
+		return
+	}
+
+	deliveries := room.feedDeliveries(event, visibleTo)
+	for feed := range room.feeds {
+		for _, delivery := range deliveries {
+			select {
+			case feed.events <- delivery:
+			default:
+				log.Printf("房间 %s: 网关订阅通道已满，丢弃事件 %d", room.ID, event.Seq)
+			}
+		}
+	}
+}
+
+// feedDeliveries 按投影结果对接收者分组，看到相同内容的玩家共用一次投递
+// 普通观战者的可见性与具体是谁无关，统一按 spectatorPrefix 计算
+// 调用方需持有 room.mu
+func (room *GameRoom) feedDeliveries(event *pb.GameEvent, visibleTo func(viewerID string) bool) []*pb.RoomEvent {
+	var deliveries []*pb.RoomEvent
+	byContent := make(map[string]*pb.RoomEvent)
+	deliveryFor := func(viewerID string) *pb.RoomEvent {
+		projected := room.projectEvent(viewerID, event)
+		data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(projected)
+		delivery, ok := byContent[string(data)]
+		if !ok {
+			delivery = &pb.RoomEvent{Event: projected}
+			byContent[string(data)] = delivery
+			deliveries = append(deliveries, delivery)
+		}
+		return delivery
+	}
+
+	for _, player := range room.sortedPlayers() {
+		if visibleTo == nil || visibleTo(player.PlayerId) {
+			delivery := deliveryFor(player.PlayerId)
+			delivery.PlayerIds = append(delivery.PlayerIds, player.PlayerId)
+		}
+	}
+	if visibleTo == nil || visibleTo(spectatorPrefix) {
+		deliveryFor(spectatorPrefix).Spectators = true
+	}
+
+	return deliveries
+}
@@ -6,79 +6,144 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
-	pb "liam/pkg/werewolf"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	pb "liam/pkg/werewolf/v1"
 )
 
+// phaseTimeout 每个阶段的最长时间
+const phaseTimeout = 60 * time.Second
+
 type WerewolfServer struct {
 	pb.UnimplementedWerewolfServiceServer
-	rooms map[string]*GameRoom
-	mu    sync.RWMutex
+	rooms      map[string]*GameRoom
+	archiver   *archiveWriter // 为 nil 时不归档
+	matchmaker *matchmaker
+	limiter    *rateLimiter // 为 nil 时不限流
+	mu         sync.RWMutex
 }
 
 type GameRoom struct {
 	ID           string
 	Name         string
+	CreatorID    string // 房主，未入座时也可以开始游戏
 	MaxPlayers   int
 	Players      map[string]*pb.Player
 	State        pb.GameState
 	CurrentPhase pb.Phase
 	DayCount     int
 	RoleConfig   map[string]int32
+	CreatedAt    time.Time
+	Visibility   *pb.VisibilityConfig
+	// 超时策略与挂机统计
+	TimeoutPolicy *pb.TimeoutPolicy
+	MissedTurns   map[string]int // player_id -> 连续超时次数
+	// 投票模式与历次投票结果
+	VoteMode    pb.VoteMode
+	VoteHistory []*pb.VoteTally
+	// 发言控制：严格模式下按座位号轮流发言
+	StrictSpeaking bool
+	Speakers       []string               // 本轮发言顺序
+	CurrentSpeaker string                 // 当前发言者，遗言阶段为出局玩家
+	chatTimes      map[string][]time.Time // 发言限流记录
+
+	// 情侣关系（双向），开局随机指定，互相可见身份
+	RandomLovers bool
+	Lovers       map[string]string
 
 	// 夜晚行动记录
 	NightActions      map[string]*pb.NightAction
-	GuardTarget       string // 守卫保护的目标
-	WerewolfTarget    string // 狼人击杀的目标
-	WitchSaveUsed     bool   // 女巫是否用过解药
-	WitchPoisonUsed   bool   // 女巫是否用过毒药
-	WitchSaveTarget   string // 女巫救人目标
-	WitchPoisonTarget string // 女巫毒人目标
+	GuardTarget       string            // 守卫保护的目标
+	WerewolfTarget    string            // 狼人击杀的目标
+	WerewolfVotes     map[string]string // 狼人提名 werewolf_id -> target_id
+	WitchSaveUsed     bool              // 女巫是否用过解药
+	WitchPoisonUsed   bool              // 女巫是否用过毒药
+	WitchSaveTarget   string            // 女巫救人目标
+	WitchPoisonTarget string            // 女巫毒人目标
+
+	// 玩家私有信息：预言家查验、女巫用药、守卫守护
+	Knowledge map[string]*pb.PrivateKnowledge
 
 	// 投票记录
 	Votes       map[string]string // voter_id -> target_id
 	DeadPlayers map[string]bool
 
+	// 复盘：时间线、死因，游戏结束时生成报告
+	StartedAt time.Time
+	Timeline  []*pb.TimelineEntry
+	Deaths    map[string]deathRecord
+	Report    *pb.GameReport
+	archiver  *archiveWriter
+
 	// 事件订阅
-	Subscribers map[string]chan *pb.GameEvent
+	Subscribers map[string]chan *pb.GameEvent // viewer_id -> 事件通道，观战者使用 viewerKey
+	Spectators  map[string]*Spectator         // spectator_id -> 观战者
+	feeds       map[*roomFeed]struct{}        // 网关的房间级订阅
+	connections map[string]*playerConnections // player_id -> 座位玩家的连接，见 presence.go
+	eventSeq    int64                         // 最新事件序号
+	eventLog    []loggedEvent                 // 最近事件，用于断线补发
+	eventAcks   map[string]int64              // viewer_id -> 已确认的事件序号
 
 	// 阶段控制
-	PhaseTimer *time.Timer
-	PhaseDone  chan bool
+	PhaseTimer    *time.Timer
+	PhaseDone     chan bool
+	PhaseDeadline time.Time // 当前阶段截止时间
+	phaseSeq      int       // 阶段序号，用于丢弃过期的定时器
 
 	mu sync.RWMutex
 }
 
 func NewWerewolfServer() *WerewolfServer {
 	return &WerewolfServer{
-		rooms: make(map[string]*GameRoom),
+		rooms:      make(map[string]*GameRoom),
+		matchmaker: newMatchmaker(),
 	}
 }
 
 // CreateRoom 创建游戏房间
 func (s *WerewolfServer) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest) (*pb.CreateRoomResponse, error) {
+	if err := checkIdentity(ctx, req.CreatorId); err != nil {
+		return nil, err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	roomID := generateRoomID()
 	room := &GameRoom{
-		ID:           roomID,
-		Name:         req.RoomName,
-		MaxPlayers:   int(req.MaxPlayers),
-		Players:      make(map[string]*pb.Player),
-		State:        pb.GameState_WAITING,
-		CurrentPhase: pb.Phase_PHASE_WAITING,
-		RoleConfig:   req.RoleConfig,
-		DeadPlayers:  make(map[string]bool),
-		Votes:        make(map[string]string),
-		NightActions: make(map[string]*pb.NightAction),
-		Subscribers:  make(map[string]chan *pb.GameEvent),
-		PhaseDone:    make(chan bool, 1),
+		ID:             roomID,
+		Name:           req.RoomName,
+		CreatorID:      req.CreatorId,
+		MaxPlayers:     int(req.MaxPlayers),
+		Players:        make(map[string]*pb.Player),
+		State:          pb.GameState_WAITING,
+		CurrentPhase:   pb.Phase_PHASE_WAITING,
+		RoleConfig:     req.RoleConfig,
+		Visibility:     req.Visibility,
+		TimeoutPolicy:  req.TimeoutPolicy,
+		MissedTurns:    make(map[string]int),
+		VoteMode:       req.VoteMode,
+		StrictSpeaking: req.StrictSpeaking,
+		RandomLovers:   req.RandomLovers,
+		Lovers:         make(map[string]string),
+		chatTimes:      make(map[string][]time.Time),
+		Spectators:     make(map[string]*Spectator),
+		CreatedAt:      time.Now(),
+		eventAcks:      make(map[string]int64),
+		WerewolfVotes:  make(map[string]string),
+		DeadPlayers:    make(map[string]bool),
+		Deaths:         make(map[string]deathRecord),
+		archiver:       s.archiver,
+		Votes:          make(map[string]string),
+		NightActions:   make(map[string]*pb.NightAction),
+		Knowledge:      make(map[string]*pb.PrivateKnowledge),
+		Subscribers:    make(map[string]chan *pb.GameEvent),
+		feeds:          make(map[*roomFeed]struct{}),
+		connections:    make(map[string]*playerConnections),
+		PhaseDone:      make(chan bool, 1),
 	}
 
 	s.rooms[roomID] = room
@@ -91,21 +156,30 @@ func (s *WerewolfServer) CreateRoom(ctx context.Context, req *pb.CreateRoomReque
 
 // JoinRoom 加入房间
 func (s *WerewolfServer) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest) (*pb.JoinRoomResponse, error) {
+	if err := checkIdentity(ctx, req.PlayerId); err != nil {
+		return nil, err
+	}
+
 	s.mu.RLock()
 	room, exists := s.rooms[req.RoomId]
 	s.mu.RUnlock()
 
 	if !exists {
-		return nil, status.Error(codes.NotFound, "房间不存在")
+		return nil, errRoomNotFound
 	}
 
 	room.mu.Lock()
 	defer room.mu.Unlock()
 
+	if req.Spectate {
+		return room.joinAsSpectator(req), nil
+	}
+
 	if len(room.Players) >= room.MaxPlayers {
 		return &pb.JoinRoomResponse{
 			Success: false,
 			Message: "房间已满",
+			Reason:  pb.ErrorReason_ERROR_REASON_ROOM_FULL,
 		}, nil
 	}
 
@@ -113,6 +187,7 @@ func (s *WerewolfServer) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest)
 		return &pb.JoinRoomResponse{
 			Success: false,
 			Message: "游戏已开始，无法加入",
+			Reason:  pb.ErrorReason_ERROR_REASON_GAME_ALREADY_STARTED,
 		}, nil
 	}
 
@@ -124,6 +199,8 @@ func (s *WerewolfServer) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest)
 		IsAlive:  true,
 		Position: int32(len(room.Players) + 1),
 		CanAct:   false,
+		Presence: pb.PresenceStatus_PRESENCE_OFFLINE,
+		LastSeen: time.Now().Unix(),
 	}
 
 	room.Players[req.PlayerId] = player
@@ -144,21 +221,35 @@ func (s *WerewolfServer) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest)
 
 // StartGame 开始游戏
 func (s *WerewolfServer) StartGame(ctx context.Context, req *pb.StartGameRequest) (*pb.StartGameResponse, error) {
+	if err := checkIdentity(ctx, req.PlayerId); err != nil {
+		return nil, err
+	}
+
 	s.mu.RLock()
 	room, exists := s.rooms[req.RoomId]
 	s.mu.RUnlock()
 
 	if !exists {
-		return nil, status.Error(codes.NotFound, "房间不存在")
+		return nil, errRoomNotFound
 	}
 
 	room.mu.Lock()
 	defer room.mu.Unlock()
 
+	// 只有已入座的玩家或房主可以开始游戏
+	if _, seated := room.Players[req.PlayerId]; !seated && (req.PlayerId == "" || req.PlayerId != room.CreatorID) {
+		return &pb.StartGameResponse{
+			Success: false,
+			Message: "只有房间内的玩家或房主可以开始游戏",
+			Reason:  pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED,
+		}, nil
+	}
+
 	if room.State != pb.GameState_WAITING {
 		return &pb.StartGameResponse{
 			Success: false,
 			Message: "游戏已经开始",
+			Reason:  pb.ErrorReason_ERROR_REASON_GAME_ALREADY_STARTED,
 		}, nil
 	}
 
@@ -167,12 +258,14 @@ func (s *WerewolfServer) StartGame(ctx context.Context, req *pb.StartGameRequest
 		return &pb.StartGameResponse{
 			Success: false,
 			Message: err.Error(),
+			Reason:  pb.ErrorReason_ERROR_REASON_INVALID_ROLE_CONFIG,
 		}, nil
 	}
 
 	room.State = pb.GameState_NIGHT
 	room.DayCount = 1
 	room.CurrentPhase = pb.Phase_PHASE_NIGHT_GUARD
+	room.StartedAt = time.Now()
 
 	// 广播游戏开始事件
 	room.broadcastEvent(&pb.GameEvent{
@@ -181,6 +274,9 @@ func (s *WerewolfServer) StartGame(ctx context.Context, req *pb.StartGameRequest
 		PhaseInfo: room.getCurrentPhaseInfo(),
 		Timestamp: time.Now().Unix(),
 	})
+	if room.RandomLovers {
+		room.pairLovers()
+	}
 
 	// 启动游戏流程控制器
 	go room.runGameLoop()
@@ -201,18 +297,30 @@ func (room *GameRoom) runGameLoop() {
 		if winner := room.checkGameOver(); winner != pb.Camp_CAMP_UNKNOWN {
 			room.State = pb.GameState_FINISHED
 			room.CurrentPhase = pb.Phase_PHASE_GAME_OVER
+			room.Report = room.buildReport(winner)
+			if room.archiver != nil {
+				room.archiver.enqueue(room.Report)
+			}
 
 			room.broadcastEvent(&pb.GameEvent{
 				EventType: pb.GameEvent_EVENT_GAME_OVER,
 				Message:   fmt.Sprintf("游戏结束！%s 阵营获胜", getCampName(winner)),
 				Timestamp: time.Now().Unix(),
+				Payload: &pb.GameEvent_GameOver{GameOver: &pb.GameOverInfo{
+					Winner: winner,
+					Report: room.Report,
+				}},
 			})
+			// 游戏结束后不再需要防止透露信息，补发全部事件
+			room.flushDelayedEvents(true)
 
 			room.mu.Unlock()
 			return
 		}
 
 		currentPhase := room.CurrentPhase
+		timeLimit := room.phaseTimeLimit(currentPhase)
+		room.PhaseDeadline = time.Now().Add(timeLimit)
 		room.mu.Unlock()
 
 		// 执行当前阶段
@@ -237,9 +345,12 @@ func (room *GameRoom) runGameLoop() {
 		select {
 		case <-room.PhaseDone:
 			// 阶段完成，继续下一阶段
-		case <-time.After(60 * time.Second):
-			// 超时，强制进入下一阶段
+		case <-time.After(timeLimit):
+			// 超时，按策略处理未行动的玩家后强制进入下一阶段
 			log.Printf("阶段 %v 超时", currentPhase)
+			room.mu.Lock()
+			room.handlePhaseTimeout(currentPhase)
+			room.mu.Unlock()
 		}
 
 		// 进入下一阶段
@@ -266,25 +377,20 @@ func (room *GameRoom) executeGuardPhase() {
 	}
 
 	if guard == nil {
-		// 没有守卫或守卫已死，跳过
-		room.PhaseDone <- true
+		// 没有守卫或守卫已死，照常广播并伪装行动时长
+		room.fakePhase("守卫请睁眼")
 		return
 	}
 
+	// 重置守卫目标
+	room.GuardTarget = ""
+
 	// 通知守卫行动
 	guard.CanAct = true
-	room.broadcastEvent(&pb.GameEvent{
-		EventType: pb.GameEvent_EVENT_YOUR_TURN,
-		Message:   "守卫请睁眼，选择你要保护的人",
-		PhaseInfo: room.getCurrentPhaseInfo(),
-		Timestamp: time.Now().Unix(),
-		ExtraData: map[string]string{
-			"target_player_id": guard.PlayerId,
-		},
+	room.announcePhase("守卫请睁眼")
+	room.notifyTurn(guard, "守卫请睁眼，选择你要保护的人", map[string]string{
+		"target_player_id": guard.PlayerId,
 	})
-
-	// 重置守卫目标
-	room.GuardTarget = ""
 }
 
 // executeWerewolfPhase 狼人阶段
@@ -304,21 +410,21 @@ func (room *GameRoom) executeWerewolfPhase() {
 	}
 
 	if len(werewolves) == 0 {
-		room.PhaseDone <- true
+		room.fakePhase("狼人请睁眼")
 		return
 	}
 
-	// 通知狼人行动
-	room.broadcastEvent(&pb.GameEvent{
-		EventType:       pb.GameEvent_EVENT_YOUR_TURN,
-		Message:         "狼人请睁眼，选择你要击杀的对象",
-		PhaseInfo:       room.getCurrentPhaseInfo(),
-		AffectedPlayers: werewolves,
-		Timestamp:       time.Now().Unix(),
-	})
-
 	// 重置狼人目标
 	room.WerewolfTarget = ""
+	room.WerewolfVotes = make(map[string]string)
+
+	// 通知狼人行动，附带狼队友列表
+	room.announcePhase("狼人请睁眼")
+	for _, werewolf := range werewolves {
+		event := room.turnEvent(werewolf, "狼人请睁眼，选择你要击杀的对象", nil)
+		event.AffectedPlayers = werewolves
+		room.sendTurnEvent(werewolf, event)
+	}
 }
 
 // executeWitchPhase 女巫阶段
@@ -338,22 +444,25 @@ func (room *GameRoom) executeWitchPhase() {
 	}
 
 	if witch == nil {
-		room.PhaseDone <- true
+		room.fakePhase("女巫请睁眼")
 		return
 	}
 
 	witch.CanAct = true
 
 	// 判断今晚是否有人被杀且未被守卫保护
-	victimID := ""
-	if room.WerewolfTarget != "" && room.WerewolfTarget != room.GuardTarget {
-		victimID = room.WerewolfTarget
-	}
+	victimID := room.nightVictimID()
 
+	prompt := &pb.WitchPrompt{
+		VictimId:        victimID,
+		SaveAvailable:   !room.WitchSaveUsed,
+		PoisonAvailable: !room.WitchPoisonUsed,
+	}
+	// Deprecated: extra_data 仅在兼容期内保留
 	extraData := map[string]string{
 		"target_player_id": witch.PlayerId,
-		"save_available":   fmt.Sprintf("%v", !room.WitchSaveUsed),
-		"poison_available": fmt.Sprintf("%v", !room.WitchPoisonUsed),
+		"save_available":   strconv.FormatBool(prompt.SaveAvailable),
+		"poison_available": strconv.FormatBool(prompt.PoisonAvailable),
 	}
 
 	message := "女巫请睁眼"
@@ -364,13 +473,10 @@ func (room *GameRoom) executeWitchPhase() {
 		message += "，今晚平安夜"
 	}
 
-	room.broadcastEvent(&pb.GameEvent{
-		EventType: pb.GameEvent_EVENT_YOUR_TURN,
-		Message:   message,
-		PhaseInfo: room.getCurrentPhaseInfo(),
-		Timestamp: time.Now().Unix(),
-		ExtraData: extraData,
-	})
+	room.announcePhase("女巫请睁眼")
+	event := room.turnEvent(witch, message, extraData)
+	event.Payload = &pb.GameEvent_WitchPrompt{WitchPrompt: prompt}
+	room.sendTurnEvent(witch, event)
 }
 
 // executeSeerPhase 预言家阶段
@@ -390,20 +496,15 @@ func (room *GameRoom) executeSeerPhase() {
 	}
 
 	if seer == nil {
-		room.PhaseDone <- true
+		room.fakePhase("预言家请睁眼")
 		return
 	}
 
 	seer.CanAct = true
+	room.announcePhase("预言家请睁眼")
 
-	room.broadcastEvent(&pb.GameEvent{
-		EventType: pb.GameEvent_EVENT_YOUR_TURN,
-		Message:   "预言家请睁眼，选择你要查验的人",
-		PhaseInfo: room.getCurrentPhaseInfo(),
-		Timestamp: time.Now().Unix(),
-		ExtraData: map[string]string{
-			"target_player_id": seer.PlayerId,
-		},
+	room.notifyTurn(seer, "预言家请睁眼，选择你要查验的人", map[string]string{
+		"target_player_id": seer.PlayerId,
 	})
 }
 
@@ -428,17 +529,21 @@ func (room *GameRoom) executeDayDiscussion() {
 		message += "，昨晚是平安夜"
 	}
 
+	report := &pb.DeathReport{Day: int32(room.DayCount)}
+	for _, p := range deadPlayers {
+		report.PlayerIds = append(report.PlayerIds, p.PlayerId)
+	}
+
 	room.broadcastEvent(&pb.GameEvent{
 		EventType:       pb.GameEvent_EVENT_PHASE_CHANGED,
 		Message:         message,
 		PhaseInfo:       room.getCurrentPhaseInfo(),
 		AffectedPlayers: deadPlayers,
 		Timestamp:       time.Now().Unix(),
+		Payload:         &pb.GameEvent_DeathReport{DeathReport: report},
 	})
 
-	// 讨论时间（可以设置为60秒）
-	time.Sleep(5 * time.Second) // 简化演示，实际应该等待用户交互
-	room.PhaseDone <- true
+	room.startDiscussion()
 }
 
 // executeVotingPhase 投票阶段
@@ -448,12 +553,8 @@ func (room *GameRoom) executeVotingPhase() {
 
 	log.Printf("房间 %s: 进入投票阶段", room.ID)
 
-	// 所有存活玩家可以投票
-	for _, player := range room.Players {
-		if player.IsAlive {
-			player.CanAct = true
-		}
-	}
+	// 清空投票记录
+	room.Votes = make(map[string]string)
 
 	room.broadcastEvent(&pb.GameEvent{
 		EventType: pb.GameEvent_EVENT_PHASE_CHANGED,
@@ -462,8 +563,13 @@ func (room *GameRoom) executeVotingPhase() {
 		Timestamp: time.Now().Unix(),
 	})
 
-	// 清空投票记录
-	room.Votes = make(map[string]string)
+	// 所有存活玩家可以投票
+	for _, player := range room.sortedPlayers() {
+		if player.IsAlive {
+			player.CanAct = true
+			room.notifyTurn(player, "请选择你要投票放逐的玩家", nil)
+		}
+	}
 }
 
 // executeLastWords 遗言阶段
@@ -474,11 +580,20 @@ func (room *GameRoom) executeLastWords() {
 	log.Printf("房间 %s: 进入遗言阶段", room.ID)
 
 	// 统计投票结果
-	votedOut := room.countVotes()
+	tally := room.countVotes()
+	room.VoteHistory = append(room.VoteHistory, tally)
+	room.broadcastEvent(&pb.GameEvent{
+		EventType: pb.GameEvent_EVENT_VOTE_RESULT,
+		Message:   room.voteSummary(tally),
+		PhaseInfo: room.getCurrentPhaseInfo(),
+		Timestamp: time.Now().Unix(),
+		Payload:   &pb.GameEvent_VoteTally{VoteTally: tally},
+	})
 
-	if votedOut != nil {
+	if votedOut := room.Players[tally.EliminatedId]; votedOut != nil {
 		votedOut.IsAlive = false
 		room.DeadPlayers[votedOut.PlayerId] = true
+		room.recordDeath(votedOut, pb.DeathCause_DEATH_CAUSE_VOTE)
 
 		room.broadcastEvent(&pb.GameEvent{
 			EventType:       pb.GameEvent_EVENT_PLAYER_DIED,
@@ -486,40 +601,45 @@ func (room *GameRoom) executeLastWords() {
 			PhaseInfo:       room.getCurrentPhaseInfo(),
 			AffectedPlayers: []*pb.Player{votedOut},
 			Timestamp:       time.Now().Unix(),
+			Payload: &pb.GameEvent_DeathReport{DeathReport: &pb.DeathReport{
+				Day:       int32(room.DayCount),
+				PlayerIds: []string{votedOut.PlayerId},
+			}},
 		})
 
-		// 等待遗言时间
-		time.Sleep(3 * time.Second)
-	} else {
-		room.broadcastEvent(&pb.GameEvent{
-			EventType: pb.GameEvent_EVENT_PHASE_CHANGED,
-			Message:   "本轮没有玩家被投票出局",
-			PhaseInfo: room.getCurrentPhaseInfo(),
-			Timestamp: time.Now().Unix(),
-		})
+		// 出局玩家在公共频道发表遗言
+		room.CurrentSpeaker = votedOut.PlayerId
+		room.finishPhaseAfter(speechDuration)
+		return
 	}
 
-	room.PhaseDone <- true
+	room.broadcastEvent(&pb.GameEvent{
+		EventType: pb.GameEvent_EVENT_PHASE_CHANGED,
+		Message:   "本轮没有玩家被投票出局",
+		PhaseInfo: room.getCurrentPhaseInfo(),
+		Timestamp: time.Now().Unix(),
+	})
+	room.finishPhase()
 }
 
 // NightAction 夜晚行动
 func (s *WerewolfServer) NightAction(ctx context.Context, req *pb.NightActionRequest) (*pb.NightActionResponse, error) {
-	s.mu.RLock()
-	room, exists := s.rooms[req.RoomId]
-	s.mu.RUnlock()
-
-	if !exists {
-		return nil, status.Error(codes.NotFound, "房间不存在")
+	sess, err := s.unarySession(ctx, req.RoomId, req.PlayerId)
+	if err != nil {
+		return nil, err
 	}
+	return sess.nightAction(req), nil
+}
 
-	room.mu.Lock()
-	defer room.mu.Unlock()
-
+// nightAction 执行夜晚行动，玩家请求和机器人托管共用
+// 调用方需持有 room.mu
+func (room *GameRoom) nightAction(req *pb.NightActionRequest) *pb.NightActionResponse {
 	if room.State != pb.GameState_NIGHT {
 		return &pb.NightActionResponse{
 			Success: false,
 			Message: "当前不是夜晚阶段",
-		}, nil
+			Reason:  pb.ErrorReason_ERROR_REASON_WRONG_PHASE,
+		}
 	}
 
 	player, exists := room.Players[req.PlayerId]
@@ -527,34 +647,59 @@ func (s *WerewolfServer) NightAction(ctx context.Context, req *pb.NightActionReq
 		return &pb.NightActionResponse{
 			Success: false,
 			Message: "玩家不存在或已死亡",
-		}, nil
+			Reason:  pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED,
+		}
 	}
 
 	if !player.CanAct {
 		return &pb.NightActionResponse{
 			Success: false,
 			Message: "当前不是你的行动时间",
-		}, nil
+			Reason:  pb.ErrorReason_ERROR_REASON_NOT_YOUR_TURN,
+		}
+	}
+
+	actionType := requestedAction(req)
+	action, targetOK := room.validateAction(player, actionType, req.TargetPlayerId)
+	if action == nil {
+		return &pb.NightActionResponse{
+			Success: false,
+			Message: "当前不能执行该行动",
+			Reason:  pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED,
+		}
+	}
+	if !targetOK {
+		return &pb.NightActionResponse{
+			Success: false,
+			Message: "行动目标不合法",
+			Reason:  pb.ErrorReason_ERROR_REASON_INVALID_TARGET,
+		}
 	}
 
 	// 根据角色和阶段处理行动
 	var result string
+	var seerResult *pb.SeerResult
 	var err error
 
 	switch player.Role {
 	case pb.Role_GUARD:
 		if room.CurrentPhase == pb.Phase_PHASE_NIGHT_GUARD {
-			room.GuardTarget = req.TargetPlayerId
-			result = "守卫成功"
+			if actionType == pb.ActionType_ACTION_GUARD {
+				room.GuardTarget = req.TargetPlayerId
+				result = "守卫成功"
+			} else {
+				result = "守卫今晚不守护"
+			}
 			player.CanAct = false
-			room.PhaseDone <- true
+			room.finishPhase()
 		} else {
-			err = errors.New("当前不是守卫阶段")
+			err = reject(pb.ErrorReason_ERROR_REASON_WRONG_PHASE, "当前不是守卫阶段")
 		}
 
 	case pb.Role_WEREWOLF:
 		if room.CurrentPhase == pb.Phase_PHASE_NIGHT_WEREWOLF {
-			room.WerewolfTarget = req.TargetPlayerId
+			room.WerewolfVotes[player.PlayerId] = req.TargetPlayerId
+			room.WerewolfTarget = room.werewolfConsensus()
 			result = "选择击杀目标成功"
 			player.CanAct = false
 
@@ -567,34 +712,33 @@ func (s *WerewolfServer) NightAction(ctx context.Context, req *pb.NightActionReq
 				}
 			}
 			if allActed {
-				room.PhaseDone <- true
+				room.finishPhase()
 			}
 		} else {
-			err = errors.New("当前不是狼人阶段")
+			err = reject(pb.ErrorReason_ERROR_REASON_WRONG_PHASE, "当前不是狼人阶段")
 		}
 
 	case pb.Role_WITCH:
 		if room.CurrentPhase == pb.Phase_PHASE_NIGHT_WITCH {
-			if req.ActionType == "save" && !room.WitchSaveUsed {
+			switch actionType {
+			case pb.ActionType_ACTION_SAVE:
 				room.WitchSaveTarget = req.TargetPlayerId
 				room.WitchSaveUsed = true
 				result = "使用解药成功"
-			} else if req.ActionType == "poison" && !room.WitchPoisonUsed {
+			case pb.ActionType_ACTION_POISON:
 				room.WitchPoisonTarget = req.TargetPlayerId
 				room.WitchPoisonUsed = true
 				result = "使用毒药成功"
-			} else if req.ActionType == "skip" {
+			default:
 				result = "女巫不使用药水"
-			} else {
-				err = errors.New("药水已用过或操作无效")
 			}
 
 			if err == nil {
 				player.CanAct = false
-				room.PhaseDone <- true
+				room.finishPhase()
 			}
 		} else {
-			err = errors.New("当前不是女巫阶段")
+			err = reject(pb.ErrorReason_ERROR_REASON_WRONG_PHASE, "当前不是女巫阶段")
 		}
 
 	case pb.Role_SEER:
@@ -605,21 +749,27 @@ func (s *WerewolfServer) NightAction(ctx context.Context, req *pb.NightActionReq
 			} else {
 				result = "这是一个好人"
 			}
+			seerResult = &pb.SeerResult{
+				TargetId: target.PlayerId,
+				Camp:     target.Camp,
+				Day:      int32(room.DayCount),
+			}
 			player.CanAct = false
-			room.PhaseDone <- true
+			room.finishPhase()
 		} else {
-			err = errors.New("当前不是预言家阶段")
+			err = reject(pb.ErrorReason_ERROR_REASON_WRONG_PHASE, "当前不是预言家阶段")
 		}
 
 	default:
-		err = errors.New("无效的角色")
+		err = reject(pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED, "无效的角色")
 	}
 
 	if err != nil {
 		return &pb.NightActionResponse{
 			Success: false,
 			Message: err.Error(),
-		}, nil
+			Reason:  rejectionReason(err),
+		}
 	}
 
 	// 记录行动
@@ -627,46 +777,75 @@ func (s *WerewolfServer) NightAction(ctx context.Context, req *pb.NightActionReq
 		PlayerId:   req.PlayerId,
 		Role:       player.Role,
 		TargetId:   req.TargetPlayerId,
-		ActionType: req.ActionType,
+		ActionType: actionType.LegacyName(),
+		Action:     actionType,
 		Timestamp:  time.Now().Unix(),
 	}
+	room.recordNightResult(player, actionType, req.TargetPlayerId, seerResult)
+	room.recordAction(player.PlayerId, actionType, req.TargetPlayerId)
 
 	return &pb.NightActionResponse{
-		Success: true,
-		Message: "行动成功",
-		Result:  result,
-	}, nil
+		Success:    true,
+		Message:    "行动成功",
+		Result:     result,
+		SeerResult: seerResult,
+	}
 }
 
 // Vote 投票
 func (s *WerewolfServer) Vote(ctx context.Context, req *pb.VoteRequest) (*pb.VoteResponse, error) {
-	s.mu.RLock()
-	room, exists := s.rooms[req.RoomId]
-	s.mu.RUnlock()
-
-	if !exists {
-		return nil, status.Error(codes.NotFound, "房间不存在")
+	sess, err := s.unarySession(ctx, req.RoomId, req.VoterId)
+	if err != nil {
+		return nil, err
 	}
+	return sess.vote(req), nil
+}
 
-	room.mu.Lock()
-	defer room.mu.Unlock()
-
+// vote 执行投票，玩家请求和机器人托管共用
+// 调用方需持有 room.mu
+func (room *GameRoom) vote(req *pb.VoteRequest) *pb.VoteResponse {
 	if room.CurrentPhase != pb.Phase_PHASE_DAY_VOTING {
 		return &pb.VoteResponse{
 			Success: false,
 			Message: "当前不是投票阶段",
-		}, nil
+			Reason:  pb.ErrorReason_ERROR_REASON_WRONG_PHASE,
+		}
 	}
 
-	player := room.Players[req.VoterId]
-	if !player.IsAlive {
+	player, exists := room.Players[req.VoterId]
+	if !exists || !player.IsAlive {
 		return &pb.VoteResponse{
 			Success: false,
 			Message: "死亡玩家不能投票",
-		}, nil
+			Reason:  pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED,
+		}
 	}
 
-	room.Votes[req.VoterId] = req.TargetId
+	actionType, targetID := pb.ActionType_ACTION_VOTE, req.TargetId
+	if req.Abstain {
+		actionType, targetID = pb.ActionType_ACTION_ABSTAIN, ""
+	}
+
+	action, targetOK := room.validateAction(player, actionType, targetID)
+	if action == nil {
+		return &pb.VoteResponse{
+			Success: false,
+			Message: "你已经投过票了",
+			Reason:  pb.ErrorReason_ERROR_REASON_ALREADY_VOTED,
+		}
+	}
+	if !targetOK {
+		return &pb.VoteResponse{
+			Success: false,
+			Message: "投票目标不合法",
+			Reason:  pb.ErrorReason_ERROR_REASON_INVALID_TARGET,
+		}
+	}
+
+	// 空目标表示弃票
+	room.Votes[req.VoterId] = targetID
+	room.recordAction(req.VoterId, actionType, targetID)
+	player.CanAct = false
 
 	// 检查是否所有人都投票了
 	allVoted := true
@@ -680,22 +859,30 @@ func (s *WerewolfServer) Vote(ctx context.Context, req *pb.VoteRequest) (*pb.Vot
 	}
 
 	if allVoted {
-		room.PhaseDone <- true
+		room.finishPhase()
 	}
 
+	message := "投票成功"
+	if req.Abstain {
+		message = "弃票成功"
+	}
 	return &pb.VoteResponse{
 		Success: true,
-		Message: "投票成功",
-	}, nil
+		Message: message,
+	}
 }
 
 // GetGameState 获取游戏状态
 func (s *WerewolfServer) GetGameState(ctx context.Context, req *pb.GetGameStateRequest) (*pb.GetGameStateResponse, error) {
+	if err := checkIdentity(ctx, req.PlayerId); err != nil {
+		return nil, err
+	}
+
 	s.mu.RLock()
 	room, exists := s.rooms[req.RoomId]
 	s.mu.RUnlock()
 	if !exists {
-		return nil, status.Error(codes.NotFound, "房间不存在")
+		return nil, errRoomNotFound
 	}
 
 	room.mu.RLock()
@@ -703,27 +890,17 @@ func (s *WerewolfServer) GetGameState(ctx context.Context, req *pb.GetGameStateR
 
 	players := make([]*pb.Player, 0, len(room.Players))
 	var currentPlayer *pb.Player
+	var knowledge *pb.PrivateKnowledge
 
-	for _, player := range room.Players {
-		visiblePlayer := &pb.Player{
-			PlayerId: player.PlayerId,
-			Name:     player.Name,
-			IsAlive:  player.IsAlive,
-			Position: player.Position,
-			CanAct:   player.CanAct,
-		}
-
-		// 只有玩家自己能看到自己的角色
-		if player.PlayerId == req.PlayerId {
-			visiblePlayer.Role = player.Role
-			visiblePlayer.Camp = player.Camp
+	viewerID := viewerKey(req.PlayerId, req.Spectator)
+	for _, player := range room.sortedPlayers() {
+		// 按房间的可见性规则过滤身份
+		players = append(players, room.visiblePlayer(viewerID, player))
+
+		if player.PlayerId == viewerID {
 			currentPlayer = player
-		} else {
-			visiblePlayer.Role = pb.Role_UNKNOWN
-			visiblePlayer.Camp = pb.Camp_CAMP_UNKNOWN
+			knowledge = room.Knowledge[player.PlayerId]
 		}
-
-		players = append(players, visiblePlayer)
 	}
 
 	return &pb.GetGameStateResponse{
@@ -733,33 +910,90 @@ func (s *WerewolfServer) GetGameState(ctx context.Context, req *pb.GetGameStateR
 		Players:       players,
 		DayCount:      int32(room.DayCount),
 		CurrentPlayer: currentPlayer,
+		Knowledge:     knowledge,
+		VoteHistory:   room.VoteHistory,
+	}, nil
+}
+
+// GetAvailableActions 获取玩家当前可执行的行动
+func (s *WerewolfServer) GetAvailableActions(ctx context.Context, req *pb.GetAvailableActionsRequest) (*pb.GetAvailableActionsResponse, error) {
+	if err := checkIdentity(ctx, req.PlayerId); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	room, exists := s.rooms[req.RoomId]
+	s.mu.RUnlock()
+	if !exists {
+		return nil, errRoomNotFound
+	}
+
+	room.mu.RLock()
+	defer room.mu.RUnlock()
+
+	player, exists := room.Players[req.PlayerId]
+	if !exists {
+		return nil, errPlayerNotFound
+	}
+
+	return &pb.GetAvailableActionsResponse{
+		RoomId:   room.ID,
+		PlayerId: player.PlayerId,
+		Phase:    room.CurrentPhase,
+		Actions:  room.availableActions(player),
+		Deadline: room.PhaseDeadline.Unix(),
 	}, nil
 }
 
 // SubscribeGameEvents 订阅游戏事件
 func (s *WerewolfServer) SubscribeGameEvents(req *pb.GetGameStateRequest, stream pb.WerewolfService_SubscribeGameEventsServer) error {
+	if err := checkIdentity(stream.Context(), req.PlayerId); err != nil {
+		return err
+	}
+
 	s.mu.RLock()
 	room, exists := s.rooms[req.RoomId]
 	s.mu.RUnlock()
 	if !exists {
-		return status.Error(codes.NotFound, "房间不存在")
+		return errRoomNotFound
 	}
 
-	// 创建事件通道
-	eventChan := make(chan *pb.GameEvent, 100)
-
+	// 创建事件通道，观战者未通过 JoinRoom 登记时按公开视角观战
+	viewerID := viewerKey(req.PlayerId, req.Spectator)
 	room.mu.Lock()
-	room.Subscribers[req.PlayerId] = eventChan
+	if req.Spectator {
+		room.watch(req.PlayerId)
+	}
+	// 补发的历史事件与订阅在同一把锁内取得，之后的事件只会从通道收到
+	var backlog []*pb.GameEvent
+	if req.LastSeq > 0 {
+		backlog = room.eventsSince(viewerID, req.LastSeq)
+	}
+	eventChan := room.subscribe(viewerID)
+	if !req.Spectator {
+		room.connect(req.PlayerId)
+	}
+	room.flushDelayedEvents(room.State == pb.GameState_FINISHED)
 	room.mu.Unlock()
 
 	// 清理订阅
 	defer func() {
 		room.mu.Lock()
-		delete(room.Subscribers, req.PlayerId)
-		close(eventChan)
+		room.unsubscribe(viewerID, eventChan)
+		if req.Spectator {
+			room.unwatch(req.PlayerId)
+		} else {
+			room.disconnect(req.PlayerId)
+		}
 		room.mu.Unlock()
 	}()
 
+	for _, event := range backlog {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+
 	// 发送事件流
 	for {
 		select {
@@ -775,12 +1009,50 @@ func (s *WerewolfServer) SubscribeGameEvents(req *pb.GetGameStateRequest, stream
 
 // 辅助方法
 func (room *GameRoom) broadcastEvent(event *pb.GameEvent) {
-	for _, ch := range room.Subscribers {
-		select {
-		case ch <- event:
-		default:
-			// 通道满了，跳过
-		}
+	room.publish(event, nil)
+}
+
+// sendToPlayer 只向指定玩家推送事件
+func (room *GameRoom) sendToPlayer(playerID string, event *pb.GameEvent) {
+	room.publish(event, func(viewerID string) bool {
+		return viewerID == playerID
+	})
+}
+
+// announcePhase 向所有人广播阶段开始，不包含任何私密信息
+func (room *GameRoom) announcePhase(message string) {
+	room.broadcastEvent(&pb.GameEvent{
+		EventType: pb.GameEvent_EVENT_PHASE_CHANGED,
+		Message:   message,
+		PhaseInfo: room.getCurrentPhaseInfo(),
+		Timestamp: time.Now().Unix(),
+		Deadline:  room.PhaseDeadline.Unix(),
+	})
+}
+
+// notifyTurn 向行动玩家推送 YOUR_TURN 事件，附带可执行行动和截止时间
+func (room *GameRoom) notifyTurn(player *pb.Player, message string, extraData map[string]string) {
+	room.sendTurnEvent(player, room.turnEvent(player, message, extraData))
+}
+
+// sendTurnEvent 推送 YOUR_TURN 事件，托管座位由机器人代为行动
+func (room *GameRoom) sendTurnEvent(player *pb.Player, event *pb.GameEvent) {
+	room.sendToPlayer(player.PlayerId, event)
+	if player.BotControlled {
+		room.scheduleBotAction(player)
+	}
+}
+
+// turnEvent 构造 YOUR_TURN 事件
+func (room *GameRoom) turnEvent(player *pb.Player, message string, extraData map[string]string) *pb.GameEvent {
+	return &pb.GameEvent{
+		EventType:        pb.GameEvent_EVENT_YOUR_TURN,
+		Message:          message,
+		PhaseInfo:        room.getCurrentPhaseInfo(),
+		Timestamp:        time.Now().Unix(),
+		ExtraData:        extraData,
+		AvailableActions: room.availableActions(player),
+		Deadline:         room.PhaseDeadline.Unix(),
 	}
 }
 func (room *GameRoom) getCurrentPhaseInfo() *pb.PhaseInfo {
@@ -798,10 +1070,26 @@ func (room *GameRoom) getCurrentPhaseInfo() *pb.PhaseInfo {
 	return &pb.PhaseInfo{
 		CurrentPhase: room.CurrentPhase,
 		PhaseName:    phaseNames[room.CurrentPhase],
-		TimeLimit:    60,
+		TimeLimit:    int32(room.phaseTimeLimit(room.CurrentPhase).Seconds()),
 	}
 }
 func (room *GameRoom) nextPhase() {
+	// 上一阶段未完成的行动和定时器作废
+	for _, player := range room.Players {
+		player.CanAct = false
+	}
+	room.phaseSeq++
+	room.flushDelayedEvents(false)
+	room.CurrentSpeaker = ""
+	if room.PhaseTimer != nil {
+		room.PhaseTimer.Stop()
+		room.PhaseTimer = nil
+	}
+	select {
+	case <-room.PhaseDone:
+	default:
+	}
+
 	phaseOrder := []pb.Phase{
 		pb.Phase_PHASE_NIGHT_GUARD,
 		pb.Phase_PHASE_NIGHT_WEREWOLF,
@@ -849,6 +1137,7 @@ func (room *GameRoom) settleNightDeaths() []*pb.Player {
 				player := room.Players[room.WerewolfTarget]
 				player.IsAlive = false
 				room.DeadPlayers[player.PlayerId] = true
+				room.recordDeath(player, pb.DeathCause_DEATH_CAUSE_WEREWOLF)
 				deadPlayers = append(deadPlayers, player)
 			}
 		}
@@ -860,33 +1149,71 @@ func (room *GameRoom) settleNightDeaths() []*pb.Player {
 		if player.IsAlive {
 			player.IsAlive = false
 			room.DeadPlayers[player.PlayerId] = true
+			room.recordDeath(player, pb.DeathCause_DEATH_CAUSE_POISON)
 			deadPlayers = append(deadPlayers, player)
 		}
 	}
 
 	return deadPlayers
 }
-func (room *GameRoom) countVotes() *pb.Player {
-	voteCount := make(map[string]int)
-	for _, targetID := range room.Votes {
-		voteCount[targetID]++
+
+// voteSummary 投票结果的文字描述，记名模式下列出每个人的投票对象
+func (room *GameRoom) voteSummary(tally *pb.VoteTally) string {
+	if len(tally.Ballots) == 0 {
+		return fmt.Sprintf("投票结束，弃票 %d 张", tally.Abstentions)
+	}
+
+	lines := make([]string, 0, len(tally.Ballots))
+	for _, ballot := range tally.Ballots {
+		if ballot.Abstain {
+			lines = append(lines, fmt.Sprintf("%s 弃票", room.playerLabel(ballot.VoterId)))
+		} else {
+			lines = append(lines, fmt.Sprintf("%s -> %s", room.playerLabel(ballot.VoterId), room.playerLabel(ballot.TargetId)))
+		}
+	}
+	return "投票结束：" + strings.Join(lines, "，")
+}
+
+func (room *GameRoom) countVotes() *pb.VoteTally {
+	tally := &pb.VoteTally{Day: int32(room.DayCount)}
+	voteCount := make(map[string]int32)
+	for _, player := range room.sortedPlayers() {
+		targetID, voted := room.Votes[player.PlayerId]
+		if !voted {
+			continue
+		}
+
+		if targetID == "" {
+			tally.Abstentions++
+		} else {
+			voteCount[targetID]++
+		}
+		// 匿名模式只公布票数
+		if room.VoteMode == pb.VoteMode_VOTE_MODE_OPEN {
+			tally.Ballots = append(tally.Ballots, &pb.Ballot{
+				VoterId:  player.PlayerId,
+				TargetId: targetID,
+				Abstain:  targetID == "",
+			})
+		}
 	}
 
-	maxVotes := 0
+	var maxVotes int32
 	var votedOutID string
 
 	for playerID, count := range voteCount {
 		if count > maxVotes {
 			maxVotes = count
 			votedOutID = playerID
+		} else if count == maxVotes {
+			// 平票无人出局
+			votedOutID = ""
 		}
 	}
 
-	if votedOutID != "" {
-		return room.Players[votedOutID]
-	}
-
-	return nil
+	tally.Counts = voteCount
+	tally.EliminatedId = votedOutID
+	return tally
 }
 func (room *GameRoom) checkGameOver() pb.Camp {
 	werewolfCount := 0
@@ -948,6 +1275,33 @@ func assignRoles(room *GameRoom) error {
 
 	return nil
 }
+
+// pairLovers 随机指定两名玩家结为情侣，记入双方的私有信息并私下通知
+// 调用方需持有 room.mu
+func (room *GameRoom) pairLovers() {
+	ids := room.alivePlayerIDs("")
+	if len(ids) < 2 {
+		return
+	}
+	rand.Shuffle(len(ids), func(i, j int) {
+		ids[i], ids[j] = ids[j], ids[i]
+	})
+
+	pair := [2]string{ids[0], ids[1]}
+	for i, id := range pair {
+		partner := pair[1-i]
+		room.Lovers[id] = partner
+		room.knowledgeOf(id).LoverId = partner
+		room.sendToPlayer(id, &pb.GameEvent{
+			EventType:       pb.GameEvent_EVENT_NIGHT_RESULT,
+			Message:         fmt.Sprintf("你与 %s 结为情侣", room.playerLabel(partner)),
+			PhaseInfo:       room.getCurrentPhaseInfo(),
+			AffectedPlayers: []*pb.Player{room.visiblePlayer(id, room.Players[partner])},
+			Timestamp:       time.Now().Unix(),
+		})
+	}
+}
+
 func stringToRole(s string) pb.Role {
 	roleMap := map[string]pb.Role{
 		"werewolf": pb.Role_WEREWOLF,
@@ -0,0 +1,946 @@
+package werewolf
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"liam/internal/models"
+	"liam/pkg/identity"
+	pb "liam/pkg/werewolf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestRoom 创建一个已分配角色的房间，玩家ID为 p1..pN，座位号与序号一致
+func newTestRoom(roles ...pb.Role) (*WerewolfServer, *GameRoom) {
+	s := NewWerewolfServer()
+	resp, _ := s.CreateRoom(context.Background(), &pb.CreateRoomRequest{
+		RoomName:   "test",
+		MaxPlayers: int32(len(roles)),
+	})
+	room := s.rooms[resp.RoomId]
+	for i, role := range roles {
+		camp := pb.Camp_CAMP_VILLAGER
+		if role == pb.Role_WEREWOLF {
+			camp = pb.Camp_CAMP_WEREWOLF
+		}
+		id := "p" + string(rune('1'+i))
+		room.Players[id] = &pb.Player{
+			PlayerId: id,
+			Name:     id,
+			Role:     role,
+			Camp:     camp,
+			IsAlive:  true,
+			Position: int32(i + 1),
+		}
+	}
+	room.State = pb.GameState_NIGHT
+	room.DayCount = 1
+	return s, room
+}
+
+func actionTypes(actions []*pb.AvailableAction) []pb.ActionType {
+	types := make([]pb.ActionType, len(actions))
+	for i, a := range actions {
+		types[i] = a.Action
+	}
+	return types
+}
+
+func TestAvailableActions_Witch(t *testing.T) {
+	_, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_WITCH, pb.Role_VILLAGER, pb.Role_GUARD)
+	room.CurrentPhase = pb.Phase_PHASE_NIGHT_WITCH
+	room.WerewolfTarget = "p3"
+	witch := room.Players["p2"]
+	witch.CanAct = true
+
+	actions := room.availableActions(witch)
+	assert.Equal(t, []pb.ActionType{pb.ActionType_ACTION_SAVE, pb.ActionType_ACTION_POISON, pb.ActionType_ACTION_SKIP}, actionTypes(actions))
+	assert.Equal(t, []string{"p3"}, actions[0].TargetIds)
+	assert.Equal(t, int32(1), actions[0].RemainingUses)
+	assert.Equal(t, []string{"p1", "p3", "p4"}, actions[1].TargetIds)
+
+	// 守卫守中狼人目标时没有人需要救
+	room.GuardTarget = "p3"
+	assert.Equal(t, []pb.ActionType{pb.ActionType_ACTION_POISON, pb.ActionType_ACTION_SKIP}, actionTypes(room.availableActions(witch)))
+
+	// 药水用完后只能跳过
+	room.WitchPoisonUsed = true
+	assert.Equal(t, []pb.ActionType{pb.ActionType_ACTION_SKIP}, actionTypes(room.availableActions(witch)))
+
+	// 其他角色在女巫阶段无行动
+	room.Players["p1"].CanAct = true
+	assert.Empty(t, room.availableActions(room.Players["p1"]))
+}
+
+func TestNightAction_RejectsIllegalTarget(t *testing.T) {
+	s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	room.CurrentPhase = pb.Phase_PHASE_NIGHT_SEER
+	room.Players["p2"].CanAct = true
+	room.Players["p4"].IsAlive = false
+
+	for _, target := range []string{"p2", "p4", "nobody"} {
+		resp, err := s.NightAction(context.Background(), &pb.NightActionRequest{
+			RoomId:         room.ID,
+			PlayerId:       "p2",
+			TargetPlayerId: target,
+			Action:         pb.ActionType_ACTION_CHECK,
+		})
+		assert.NoError(t, err)
+		assert.False(t, resp.Success, target)
+	}
+
+	// 兼容期内旧客户端仍可只传字符串 action_type
+	resp, err := s.NightAction(context.Background(), &pb.NightActionRequest{
+		RoomId:         room.ID,
+		PlayerId:       "p2",
+		TargetPlayerId: "p1",
+		ActionType:     "check",
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, "这是一个狼人", resp.Result)
+	assert.Equal(t, pb.Camp_CAMP_WEREWOLF, resp.SeerResult.GetCamp())
+
+	// 查验结果保存在服务端，只有预言家本人能取回
+	state, err := s.GetGameState(context.Background(), &pb.GetGameStateRequest{RoomId: room.ID, PlayerId: "p2"})
+	assert.NoError(t, err)
+	assert.Len(t, state.Knowledge.GetSeerChecks(), 1)
+	assert.Equal(t, "p1", state.Knowledge.SeerChecks[0].TargetId)
+
+	state, err = s.GetGameState(context.Background(), &pb.GetGameStateRequest{RoomId: room.ID, PlayerId: "p3"})
+	assert.NoError(t, err)
+	assert.Nil(t, state.Knowledge)
+}
+
+func TestGetGameState_Visibility(t *testing.T) {
+	s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER)
+	room.Visibility = &pb.VisibilityConfig{DeadGodView: true}
+
+	roles := func(viewerID string) []pb.Role {
+		state, err := s.GetGameState(context.Background(), &pb.GetGameStateRequest{RoomId: room.ID, PlayerId: viewerID})
+		assert.NoError(t, err)
+		result := make([]pb.Role, len(state.Players))
+		for i, p := range state.Players {
+			result[i] = p.Role
+		}
+		return result
+	}
+
+	unknown := pb.Role_UNKNOWN
+	// 狼人能看到狼队友
+	assert.Equal(t, []pb.Role{pb.Role_WEREWOLF, pb.Role_WEREWOLF, unknown, unknown}, roles("p1"))
+	// 好人只能看到自己
+	assert.Equal(t, []pb.Role{unknown, unknown, pb.Role_SEER, unknown}, roles("p3"))
+	// 观战者默认看不到任何身份
+	assert.Equal(t, []pb.Role{unknown, unknown, unknown, unknown}, roles("spectator"))
+
+	// 死亡玩家开启上帝视角
+	room.Players["p4"].IsAlive = false
+	assert.Equal(t, []pb.Role{pb.Role_WEREWOLF, pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER}, roles("p4"))
+
+	// 情侣互相可见
+	room.Lovers["p3"] = "p2"
+	assert.Equal(t, []pb.Role{unknown, pb.Role_WEREWOLF, pb.Role_SEER, unknown}, roles("p3"))
+}
+
+func TestPairLovers(t *testing.T) {
+	_, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	events := make(map[string]chan *pb.GameEvent)
+	for id := range room.Players {
+		events[id] = make(chan *pb.GameEvent, 1)
+		room.Subscribers[id] = events[id]
+	}
+
+	room.pairLovers()
+
+	assert.Len(t, room.Lovers, 2)
+	for id, partner := range room.Lovers {
+		assert.NotEqual(t, id, partner)
+		assert.Equal(t, id, room.Lovers[partner])
+		assert.Equal(t, partner, room.knowledgeOf(id).LoverId)
+		assert.True(t, room.canSeeRole(id, room.Players[partner]))
+
+		// 只有情侣双方收到通知，且能看到对方身份
+		event := <-events[id]
+		assert.Equal(t, pb.GameEvent_EVENT_NIGHT_RESULT, event.EventType)
+		assert.Equal(t, room.Players[partner].Role, event.AffectedPlayers[0].Role)
+	}
+	for id, ch := range events {
+		if _, paired := room.Lovers[id]; !paired {
+			assert.Empty(t, ch)
+		}
+	}
+}
+
+func TestExecuteSeerPhase_AbsentSeerIsDelayed(t *testing.T) {
+	fakePhaseMin, fakePhaseMax = 30*time.Millisecond, 40*time.Millisecond
+	defer func() { fakePhaseMin, fakePhaseMax = 10*time.Second, phaseTimeout }()
+
+	_, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	room.Players["p2"].IsAlive = false
+	room.CurrentPhase = pb.Phase_PHASE_NIGHT_SEER
+	events := make(chan *pb.GameEvent, 10)
+	room.Subscribers["p3"] = events
+
+	room.executeSeerPhase()
+
+	// 照常广播预言家阶段，但不会立即结束
+	event := <-events
+	assert.Equal(t, "预言家请睁眼", event.Message)
+	select {
+	case <-room.PhaseDone:
+		t.Fatal("缺席角色的阶段不应立即结束")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	select {
+	case <-room.PhaseDone:
+	case <-time.After(time.Second):
+		t.Fatal("伪装时长结束后阶段应完成")
+	}
+}
+
+func TestHandlePhaseTimeout_AFK(t *testing.T) {
+	_, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	room.TimeoutPolicy = &pb.TimeoutPolicy{WerewolfRandomKill: true, BotTakeover: true}
+	wolf := room.Players["p1"]
+
+	// 狼人超时按策略随机击杀一名好人
+	room.CurrentPhase = pb.Phase_PHASE_NIGHT_WEREWOLF
+	wolf.CanAct = true
+	room.handlePhaseTimeout(room.CurrentPhase)
+	assert.Contains(t, []string{"p2", "p3", "p4"}, room.WerewolfTarget)
+	assert.False(t, wolf.IsAfk)
+
+	// 未投票视为弃票，连续两次超时被标记挂机并由机器人托管
+	room.State = pb.GameState_DAY
+	room.CurrentPhase = pb.Phase_PHASE_DAY_VOTING
+	wolf.CanAct = true
+	room.handlePhaseTimeout(room.CurrentPhase)
+	vote, voted := room.Votes["p1"]
+	assert.True(t, voted)
+	assert.Empty(t, vote)
+	assert.True(t, wolf.IsAfk)
+	assert.True(t, wolf.BotControlled)
+
+	// 玩家主动行动后恢复
+	room.markActive(wolf)
+	assert.False(t, wolf.IsAfk)
+	assert.Zero(t, room.MissedTurns["p1"])
+}
+
+func TestHandlePhaseTimeout_Policy(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      *pb.TimeoutPolicy
+		wantVote    bool
+		wantChecked bool
+	}{
+		{"默认弃票且不查验", &pb.TimeoutPolicy{}, false, false},
+		{"随机投票并随机查验", &pb.TimeoutPolicy{VoteRandomTarget: true, SeerRandomCheck: true}, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+			room.TimeoutPolicy = tt.policy
+			seer := room.Players["p2"]
+
+			room.State = pb.GameState_NIGHT
+			room.CurrentPhase = pb.Phase_PHASE_NIGHT_SEER
+			seer.CanAct = true
+			room.handlePhaseTimeout(room.CurrentPhase)
+			checks := room.knowledgeOf("p2").GetSeerChecks()
+			if tt.wantChecked {
+				assert.Len(t, checks, 1)
+				assert.NotEqual(t, "p2", checks[0].TargetId)
+				assert.Contains(t, room.NightActions, "p2")
+			} else {
+				assert.Empty(t, checks)
+			}
+
+			room.State = pb.GameState_DAY
+			room.CurrentPhase = pb.Phase_PHASE_DAY_VOTING
+			seer.CanAct = true
+			room.handlePhaseTimeout(room.CurrentPhase)
+			vote, voted := room.Votes["p2"]
+			assert.True(t, voted)
+			if tt.wantVote {
+				assert.Contains(t, []string{"p1", "p3", "p4"}, vote)
+			} else {
+				assert.Empty(t, vote)
+			}
+		})
+	}
+}
+
+func TestStartGame_Authorization(t *testing.T) {
+	tests := []struct {
+		name     string
+		caller   string
+		playerID string
+		wantCode codes.Code
+		reason   pb.ErrorReason
+	}{
+		{"已入座的玩家", "p2", "p2", codes.OK, pb.ErrorReason_ERROR_REASON_INVALID_ROLE_CONFIG},
+		{"未入座的房主", "host", "host", codes.OK, pb.ErrorReason_ERROR_REASON_INVALID_ROLE_CONFIG},
+		{"未入座的其他玩家", "p9", "p9", codes.OK, pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED},
+		{"未指定玩家", "", "", codes.OK, pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED},
+		{"冒充房主", "p9", "host", codes.PermissionDenied, pb.ErrorReason_ERROR_REASON_UNSPECIFIED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+			room.CreatorID = "host"
+			room.State = pb.GameState_WAITING
+			// 角色配置为空，通过校验的请求在分配角色时失败，不会启动游戏循环
+			room.RoleConfig = nil
+
+			ctx := context.Background()
+			if tt.caller != "" {
+				ctx = identity.NewContext(ctx, tt.caller)
+			}
+			resp, err := s.StartGame(ctx, &pb.StartGameRequest{RoomId: room.ID, PlayerId: tt.playerID})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.False(t, resp.GetSuccess())
+			assert.Equal(t, tt.reason, resp.GetReason())
+			assert.Equal(t, pb.GameState_WAITING, room.State)
+		})
+	}
+}
+
+func TestVote_AbstainAndBreakdown(t *testing.T) {
+	s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	room.State = pb.GameState_DAY
+	room.CurrentPhase = pb.Phase_PHASE_DAY_VOTING
+	for _, p := range room.Players {
+		p.CanAct = true
+	}
+
+	votes := []*pb.VoteRequest{
+		{VoterId: "p1", TargetId: "p2"},
+		{VoterId: "p2", Abstain: true},
+		{VoterId: "p3", TargetId: "p1"},
+		{VoterId: "p4", TargetId: "p2"},
+	}
+	for _, v := range votes {
+		v.RoomId = room.ID
+		resp, err := s.Vote(context.Background(), v)
+		assert.NoError(t, err)
+		assert.True(t, resp.Success, resp.Message)
+	}
+
+	tally := room.countVotes()
+	assert.Equal(t, "p2", tally.EliminatedId)
+	assert.Equal(t, int32(1), tally.Abstentions)
+	assert.Equal(t, map[string]int32{"p1": 1, "p2": 2}, tally.Counts)
+	assert.Len(t, tally.Ballots, 4)
+	assert.True(t, tally.Ballots[1].Abstain)
+
+	// 匿名模式只公布票数
+	room.VoteMode = pb.VoteMode_VOTE_MODE_ANONYMOUS
+	assert.Empty(t, room.countVotes().Ballots)
+}
+
+func TestSendChat_Channels(t *testing.T) {
+	s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER)
+	room.Players["p4"].IsAlive = false
+	inbox := make(map[string]chan *pb.GameEvent)
+	for _, id := range []string{"p1", "p2", "p3", "p4", "spectator"} {
+		inbox[id] = make(chan *pb.GameEvent, 10)
+		room.Subscribers[id] = inbox[id]
+	}
+	received := func() []string {
+		var ids []string
+		for _, id := range []string{"p1", "p2", "p3", "p4", "spectator"} {
+			select {
+			case <-inbox[id]:
+				ids = append(ids, id)
+			default:
+			}
+		}
+		return ids
+	}
+	send := func(playerID string, channel pb.ChatChannel, content string) bool {
+		resp, err := s.SendChatMessage(context.Background(), &pb.SendChatMessageRequest{
+			RoomId:   room.ID,
+			PlayerId: playerID,
+			Channel:  channel,
+			Content:  content,
+		})
+		assert.NoError(t, err)
+		return resp.Success
+	}
+
+	// 夜晚只有狼人频道开放，消息只投递给狼人
+	assert.False(t, send("p3", pb.ChatChannel_CHAT_CHANNEL_PUBLIC, "天黑了"))
+	assert.True(t, send("p1", pb.ChatChannel_CHAT_CHANNEL_WEREWOLF, "刀3号"))
+	assert.Equal(t, []string{"p1", "p2"}, received())
+
+	// 死亡频道对存活玩家不可见
+	assert.True(t, send("p4", pb.ChatChannel_CHAT_CHANNEL_DEAD, "我是村民"))
+	assert.Equal(t, []string{"p4"}, received())
+
+	// 严格发言模式下只有当前发言者可以发言
+	room.State = pb.GameState_DAY
+	room.StrictSpeaking = true
+	room.CurrentSpeaker = "p3"
+	assert.False(t, send("p1", pb.ChatChannel_CHAT_CHANNEL_PUBLIC, "插话"))
+	assert.True(t, send("p3", pb.ChatChannel_CHAT_CHANNEL_PUBLIC, "我是预言家"))
+	assert.Len(t, received(), 5)
+
+	// 长度和频率限制
+	assert.False(t, send("p3", pb.ChatChannel_CHAT_CHANNEL_PUBLIC, strings.Repeat("字", maxChatLength+1)))
+	for i := 1; i < chatRateLimit; i++ {
+		assert.True(t, send("p3", pb.ChatChannel_CHAT_CHANNEL_PUBLIC, "查杀1号"))
+	}
+	assert.False(t, send("p3", pb.ChatChannel_CHAT_CHANNEL_PUBLIC, "查杀1号"))
+}
+
+func TestGameSession(t *testing.T) {
+	s := NewWerewolfServer()
+	created, _ := s.CreateRoom(context.Background(), &pb.CreateRoomRequest{RoomName: "test", MaxPlayers: 4})
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterWerewolfServiceServer(srv, s)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := pb.NewWerewolfServiceClient(conn).GameSession(ctx)
+	assert.NoError(t, err)
+
+	// 加入前的请求返回错误
+	assert.NoError(t, stream.Send(&pb.SessionRequest{RequestId: "r1", Request: &pb.SessionRequest_Vote{Vote: &pb.VoteRequest{TargetId: "p2"}}}))
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "r1", resp.RequestId)
+	assert.Equal(t, codes.FailedPrecondition.String(), resp.GetError().GetCode())
+
+	assert.NoError(t, stream.Send(&pb.SessionRequest{RequestId: "r2", Request: &pb.SessionRequest_Join{Join: &pb.SessionJoin{
+		RoomId: created.RoomId, PlayerId: "p1", PlayerName: "Alice",
+	}}}))
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "r2", resp.RequestId)
+	assert.True(t, resp.GetJoin().GetSuccess())
+	assert.Equal(t, int32(1), resp.GetJoin().GetPlayer().GetPosition())
+
+	// 聊天结果通过关联ID对应请求，事件由服务端推送
+	assert.NoError(t, stream.Send(&pb.SessionRequest{RequestId: "r3", Request: &pb.SessionRequest_Chat{Chat: &pb.SendChatMessageRequest{
+		Channel: pb.ChatChannel_CHAT_CHANNEL_PUBLIC, Content: "大家好",
+	}}}))
+	var gotResult, gotEvent bool
+	for !gotResult || !gotEvent {
+		resp, err = stream.Recv()
+		assert.NoError(t, err)
+		switch {
+		case resp.GetChat() != nil:
+			assert.Equal(t, "r3", resp.RequestId)
+			assert.True(t, resp.GetChat().Success)
+			gotResult = true
+		case resp.GetEvent().GetEventType() == pb.GameEvent_EVENT_CHAT:
+			assert.Empty(t, resp.RequestId)
+			assert.Equal(t, "大家好", resp.GetEvent().GetChat().GetContent())
+			assert.NotZero(t, resp.GetEvent().Seq)
+			gotEvent = true
+		}
+	}
+}
+
+func TestSpectator_Streams(t *testing.T) {
+	s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	room.Visibility = &pb.VisibilityConfig{SpectatorGodView: true}
+	resp, err := s.JoinRoom(context.Background(), &pb.JoinRoomRequest{RoomId: room.ID, PlayerId: "god", PlayerName: "上帝", Spectate: true, GodView: true})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	// 两条事件流中的一条关闭，另一条仍在使用
+	room.watch("god")
+	room.watch("god")
+	room.unwatch("god")
+	assert.Equal(t, 1, room.spectatorCount())
+
+	// 全部关闭后保留登记，重新订阅不会丢失名字和上帝视角
+	room.unwatch("god")
+	assert.Equal(t, 0, room.spectatorCount())
+	room.watch("god")
+	assert.Equal(t, &Spectator{ID: "god", Name: "上帝", GodView: true, joined: true, streams: 1}, room.Spectators["god"])
+
+	// 未通过 JoinRoom 登记的观战者在最后一条流关闭时移除
+	room.watch("guest")
+	room.unwatch("guest")
+	assert.NotContains(t, room.Spectators, "guest")
+}
+
+func TestSpectator_DelayedGodView(t *testing.T) {
+	s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	room.Visibility = &pb.VisibilityConfig{SpectatorGodView: true, SpectatorDelayPhases: 1}
+	room.CurrentPhase = pb.Phase_PHASE_NIGHT_SEER
+
+	join := func(id string, godView bool) *pb.JoinRoomResponse {
+		resp, err := s.JoinRoom(context.Background(), &pb.JoinRoomRequest{RoomId: room.ID, PlayerId: id, Spectate: true, GodView: godView})
+		assert.NoError(t, err)
+		return resp
+	}
+	// 入座玩家不能观战自己的房间
+	assert.False(t, join("p1", true).Success)
+	assert.True(t, join("p1x", false).Success)
+	assert.True(t, join("god", true).Success)
+
+	public := room.subscribe(viewerKey("p1x", true))
+	god := room.subscribe(viewerKey("god", true))
+	room.watch("p1x")
+	room.watch("god")
+
+	rooms, err := s.ListRooms(context.Background(), &pb.ListRoomsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), rooms.Rooms[0].SpectatorCount)
+
+	room.announcePhase("预言家请睁眼")
+	room.sendToPlayer("p2", &pb.GameEvent{EventType: pb.GameEvent_EVENT_NIGHT_RESULT, Message: "1号是狼人"})
+
+	// 公开视角实时收到公开事件，上帝视角暂时收不到任何事件
+	assert.Len(t, public, 1)
+	assert.Len(t, god, 0)
+
+	// 延迟一个阶段后收到包括私密事件在内的完整事件
+	room.nextPhase()
+	assert.Len(t, god, 2)
+	<-god
+	assert.Equal(t, "1号是狼人", (<-god).Message)
+}
+
+func TestGetGameReport(t *testing.T) {
+	s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_WITCH, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	req := &pb.GetGameReportRequest{RoomId: room.ID}
+
+	_, err := s.GetGameReport(context.Background(), req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	room.CurrentPhase = pb.Phase_PHASE_NIGHT_WEREWOLF
+	room.recordAction("p1", pb.ActionType_ACTION_KILL, "p3")
+	room.Players["p3"].IsAlive = false
+	room.recordDeath(room.Players["p3"], pb.DeathCause_DEATH_CAUSE_WEREWOLF)
+	room.Report = room.buildReport(pb.Camp_CAMP_WEREWOLF)
+
+	resp, err := s.GetGameReport(context.Background(), req)
+	assert.NoError(t, err)
+	report := resp.Report
+	assert.Len(t, report.Timeline, 2)
+	assert.Equal(t, pb.Role_WITCH, report.Players[1].Player.Role)
+	assert.Equal(t, pb.DeathCause_DEATH_CAUSE_WEREWOLF, report.Players[2].DeathCause)
+	assert.Equal(t, int32(1), report.Players[2].DeathDay)
+	assert.Equal(t, pb.DeathCause_DEATH_CAUSE_NONE, report.Players[0].DeathCause)
+}
+
+// flakyStore 前 failures 次写入失败
+type flakyStore struct {
+	failures int
+	saved    []*models.Game
+}
+
+func (f *flakyStore) SaveGame(ctx context.Context, game *models.Game) error {
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("connection refused")
+	}
+	f.saved = append(f.saved, game)
+	return nil
+}
+
+func TestArchiveWriter_Retry(t *testing.T) {
+	backoff := archiveRetryBackoff
+	archiveRetryBackoff = time.Millisecond
+	defer func() { archiveRetryBackoff = backoff }()
+
+	_, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	room.recordAction("p2", pb.ActionType_ACTION_CHECK, "p1")
+	room.recordAction("p2", pb.ActionType_ACTION_CHECK, "p3")
+	room.Players["p1"].IsAlive = false
+	room.recordDeath(room.Players["p1"], pb.DeathCause_DEATH_CAUSE_VOTE)
+	report := room.buildReport(pb.Camp_CAMP_VILLAGER)
+
+	store := &flakyStore{failures: 2}
+	w := &archiveWriter{store: store}
+	w.save(archiveRecord(report))
+
+	assert.Len(t, store.saved, 1)
+	game := store.saved[0]
+	assert.Equal(t, room.ID, game.RoomID)
+	assert.Len(t, game.Participants, 4)
+	assert.False(t, game.Participants[0].Won)
+	assert.Equal(t, "DEATH_CAUSE_VOTE", game.Participants[0].DeathCause)
+	assert.True(t, game.Participants[1].Won)
+	// 只有查验出狼人才算关键行动
+	assert.Equal(t, 1, game.Participants[1].CorrectChecks)
+	assert.Len(t, game.Events, 3)
+}
+
+func TestMatchBoard_GroupsBySkill(t *testing.T) {
+	delay := matchStartDelay
+	matchStartDelay = time.Hour
+	defer func() { matchStartDelay = delay }()
+
+	s := NewWerewolfServer()
+	now := time.Now()
+	entries := make([]*queueEntry, 0, 7)
+	for i, rating := range []int{2000, 1500, 1520, 1480, 1550, 1510, 1490} {
+		entry := &queueEntry{
+			playerID: "p" + string(rune('1'+i)),
+			board:    "standard_6",
+			rating:   rating,
+			joinedAt: now.Add(time.Duration(i) * time.Second),
+			updates:  make(chan *pb.QueueUpdate, 1),
+		}
+		assert.True(t, s.matchmaker.add(entry))
+		entries = append(entries, entry)
+	}
+	assert.False(t, s.matchmaker.add(&queueEntry{playerID: "p2", board: "standard_9"}))
+
+	s.matchBoard("standard_6")
+
+	// 分差过大的玩家继续排队
+	waiting := <-entries[0].updates
+	assert.Equal(t, pb.QueueUpdate_STATUS_WAITING, waiting.Status)
+	assert.Equal(t, int32(1), waiting.Position)
+
+	matched := <-entries[1].updates
+	assert.Equal(t, pb.QueueUpdate_STATUS_MATCHED, matched.Status)
+	room := s.rooms[matched.RoomId]
+	assert.Len(t, room.Players, 6)
+	assert.NotContains(t, room.Players, "p1")
+	assert.Equal(t, pb.GameState_WAITING, room.State)
+}
+
+func TestMatchmaker_RequeueSkipsLeftPlayers(t *testing.T) {
+	mm := newMatchmaker()
+	now := time.Now()
+	group := make([]*queueEntry, 0, 3)
+	for i := 0; i < 3; i++ {
+		entry := &queueEntry{
+			playerID: "p" + string(rune('1'+i)),
+			board:    "standard_6",
+			joinedAt: now.Add(time.Duration(i) * time.Second),
+			updates:  make(chan *pb.QueueUpdate, 1),
+			done:     make(chan struct{}),
+		}
+		assert.True(t, mm.add(entry))
+		group = append(group, entry)
+	}
+
+	mm.mu.Lock()
+	mm.take("standard_6", group)
+	mm.mu.Unlock()
+
+	// 开局期间 p2 的流结束，开局失败后不再放回队列
+	mm.remove(group[1])
+	mm.mu.Lock()
+	mm.requeue("standard_6", group)
+	queue := mm.queues["standard_6"]
+	mm.mu.Unlock()
+
+	assert.Equal(t, []*queueEntry{group[0], group[2]}, queue)
+}
+
+func TestIdentity_SignedPlayer(t *testing.T) {
+	s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	signer := identity.NewSigner("secret")
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.UnaryInterceptor(signer.UnaryServerInterceptor()))
+	pb.RegisterWerewolfServiceServer(srv, s)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	dial := func(signer *identity.Signer) pb.WerewolfServiceClient {
+		conn, err := grpc.NewClient("passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithUnaryInterceptor(signer.UnaryClientInterceptor()))
+		assert.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return pb.NewWerewolfServiceClient(conn)
+	}
+	client := dial(signer)
+	ctx := identity.NewContext(context.Background(), "p1")
+
+	_, err := client.GetGameState(ctx, &pb.GetGameStateRequest{RoomId: room.ID, PlayerId: "p1"})
+	assert.NoError(t, err)
+
+	// 不能以其他座位的身份请求
+	_, err = client.GetGameState(ctx, &pb.GetGameStateRequest{RoomId: room.ID, PlayerId: "p2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// 没有身份或签名密钥不一致时拒绝
+	_, err = client.GetGameState(context.Background(), &pb.GetGameStateRequest{RoomId: room.ID, PlayerId: "p1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = dial(identity.NewSigner("other")).GetGameState(ctx, &pb.GetGameStateRequest{RoomId: room.ID, PlayerId: "p1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestReadyAndEndSpeech(t *testing.T) {
+	_, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER)
+	room.mu.Lock()
+	defer room.mu.Unlock()
+
+	// 只能在开局前准备
+	assert.False(t, room.setReady("p1", true).Success)
+	room.State = pb.GameState_WAITING
+	assert.True(t, room.setReady("p1", true).Success)
+	assert.True(t, room.Players["p1"].Ready)
+	assert.False(t, room.setReady("spectator", true).Success)
+
+	// 严格发言模式下结束发言轮到下一位
+	room.State = pb.GameState_DAY
+	room.CurrentPhase = pb.Phase_PHASE_DAY_DISCUSSION
+	room.StrictSpeaking = true
+	room.startDiscussion()
+	defer func() { room.PhaseTimer.Stop() }()
+
+	assert.Equal(t, "p1", room.CurrentSpeaker)
+	assert.False(t, room.endSpeech("p2").Success)
+	assert.True(t, room.endSpeech("p1").Success)
+	assert.Equal(t, "p2", room.CurrentSpeaker)
+}
+
+func TestFeedDeliveries_GroupsByVisibility(t *testing.T) {
+	_, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER)
+	room.mu.Lock()
+	defer room.mu.Unlock()
+
+	// 狼人能看到同伴的身份，其他玩家和观战者看到的内容相同
+	deliveries := room.feedDeliveries(&pb.GameEvent{
+		EventType:       pb.GameEvent_EVENT_PLAYER_DIED,
+		AffectedPlayers: []*pb.Player{room.Players["p1"]},
+	}, nil)
+	assert.Len(t, deliveries, 2)
+	assert.Equal(t, []string{"p1", "p2"}, deliveries[0].PlayerIds)
+	assert.Equal(t, pb.Role_WEREWOLF, deliveries[0].Event.AffectedPlayers[0].Role)
+	assert.False(t, deliveries[0].Spectators)
+	assert.Equal(t, []string{"p3", "p4"}, deliveries[1].PlayerIds)
+	assert.True(t, deliveries[1].Spectators)
+	assert.Equal(t, pb.Role_UNKNOWN, deliveries[1].Event.AffectedPlayers[0].Role)
+
+	// 私密事件只投递给可见的玩家
+	deliveries = room.feedDeliveries(&pb.GameEvent{EventType: pb.GameEvent_EVENT_NIGHT_RESULT}, func(viewerID string) bool {
+		return viewerID == "p3"
+	})
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, []string{"p3"}, deliveries[0].PlayerIds)
+	assert.False(t, deliveries[0].Spectators)
+}
+
+func TestSubscribeGameEvents_ResumesFromLastSeq(t *testing.T) {
+	s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+
+	room.mu.Lock()
+	room.broadcastEvent(&pb.GameEvent{EventType: pb.GameEvent_EVENT_PHASE_CHANGED, Message: "第一条"})
+	lastSeq := room.eventSeq
+	room.broadcastEvent(&pb.GameEvent{EventType: pb.GameEvent_EVENT_PHASE_CHANGED, Message: "第二条"})
+	room.sendToPlayer("p2", &pb.GameEvent{EventType: pb.GameEvent_EVENT_PHASE_CHANGED, Message: "只有2号可见"})
+	room.mu.Unlock()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterWerewolfServiceServer(srv, s)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := pb.NewWerewolfServiceClient(conn).SubscribeGameEvents(ctx, &pb.GetGameStateRequest{
+		RoomId: room.ID, PlayerId: "p1", LastSeq: lastSeq,
+	})
+	assert.NoError(t, err)
+
+	// 只补发之后的、自己可见的事件
+	event, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, lastSeq+1, event.Seq)
+	assert.Equal(t, "第二条", event.Message)
+
+	// 订阅后玩家上线，之后的事件实时推送
+	event, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, pb.GameEvent_EVENT_PLAYER_PRESENCE, event.EventType)
+	room.mu.Lock()
+	room.broadcastEvent(&pb.GameEvent{EventType: pb.GameEvent_EVENT_PHASE_CHANGED, Message: "第三条"})
+	room.mu.Unlock()
+	event, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "第三条", event.Message)
+}
+
+func TestPresence_ReconnectingThenOffline(t *testing.T) {
+	grace := reconnectGrace
+	reconnectGrace = 20 * time.Millisecond
+	defer func() { reconnectGrace = grace }()
+
+	_, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	presence := func() pb.PresenceStatus {
+		room.mu.RLock()
+		defer room.mu.RUnlock()
+		return room.Players["p1"].Presence
+	}
+
+	room.mu.Lock()
+	room.connect("p1")
+	room.connect("p1")
+	room.disconnect("p1")
+	room.mu.Unlock()
+	// 还有一条连接，仍然在线
+	assert.Equal(t, pb.PresenceStatus_PRESENCE_ONLINE, presence())
+
+	room.mu.Lock()
+	room.disconnect("p1")
+	room.mu.Unlock()
+	assert.Equal(t, pb.PresenceStatus_PRESENCE_RECONNECTING, presence())
+
+	// 等待期间重连，之前的定时器不再生效
+	room.mu.Lock()
+	room.connect("p1")
+	room.mu.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, pb.PresenceStatus_PRESENCE_ONLINE, presence())
+
+	room.mu.Lock()
+	room.disconnect("p1")
+	room.mu.Unlock()
+	assert.Eventually(t, func() bool { return presence() == pb.PresenceStatus_PRESENCE_OFFLINE }, time.Second, 10*time.Millisecond)
+
+	// 每次变化都广播给所有人
+	room.mu.RLock()
+	defer room.mu.RUnlock()
+	var changes []pb.PresenceStatus
+	for _, logged := range room.eventLog {
+		if logged.event.EventType == pb.GameEvent_EVENT_PLAYER_PRESENCE {
+			assert.Nil(t, logged.visibleTo)
+			changes = append(changes, logged.event.AffectedPlayers[0].Presence)
+		}
+	}
+	assert.Len(t, changes, 5)
+}
+
+func TestErrorReasons(t *testing.T) {
+	s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	room.CurrentPhase = pb.Phase_PHASE_NIGHT_SEER
+
+	// 不存在的房间和玩家同为 NotFound，通过错误详情区分
+	_, err := s.GetGameState(context.Background(), &pb.GetGameStateRequest{RoomId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, pb.ErrorReason_ERROR_REASON_ROOM_NOT_FOUND, statusReason(err))
+
+	_, err = s.GetAvailableActions(context.Background(), &pb.GetAvailableActionsRequest{RoomId: room.ID, PlayerId: "nobody"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, pb.ErrorReason_ERROR_REASON_PLAYER_NOT_FOUND, statusReason(err))
+
+	// 业务拒绝通过 reason 区分
+	action, err := s.NightAction(context.Background(), &pb.NightActionRequest{
+		RoomId:         room.ID,
+		PlayerId:       "p2",
+		TargetPlayerId: "p1",
+		Action:         pb.ActionType_ACTION_CHECK,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ErrorReason_ERROR_REASON_NOT_YOUR_TURN, action.Reason)
+
+	room.Players["p2"].CanAct = true
+	room.CurrentPhase = pb.Phase_PHASE_NIGHT_GUARD
+	action, err = s.NightAction(context.Background(), &pb.NightActionRequest{
+		RoomId:         room.ID,
+		PlayerId:       "p2",
+		TargetPlayerId: "p1",
+		Action:         pb.ActionType_ACTION_CHECK,
+	})
+	assert.NoError(t, err)
+	assert.False(t, action.Success)
+	assert.Equal(t, pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED, action.Reason)
+
+	join, err := s.JoinRoom(context.Background(), &pb.JoinRoomRequest{RoomId: room.ID, PlayerId: "p5", PlayerName: "p5"})
+	assert.NoError(t, err)
+	assert.False(t, join.Success)
+	assert.Equal(t, pb.ErrorReason_ERROR_REASON_ROOM_FULL, join.Reason)
+
+	// 入座玩家不能在观战频道发言，未知频道按消息不合法处理
+	for channel, reason := range map[pb.ChatChannel]pb.ErrorReason{
+		pb.ChatChannel_CHAT_CHANNEL_SPECTATOR: pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED,
+		pb.ChatChannel(99):                    pb.ErrorReason_ERROR_REASON_INVALID_MESSAGE,
+	} {
+		chat, err := s.SendChatMessage(context.Background(), &pb.SendChatMessageRequest{RoomId: room.ID, PlayerId: "p1", Channel: channel, Content: "你好"})
+		assert.NoError(t, err)
+		assert.False(t, chat.Success)
+		assert.Equal(t, reason, chat.Reason, channel.String())
+	}
+}
+
+func TestServerOptions_RecoveryAndRateLimit(t *testing.T) {
+	s, room := newTestRoom(pb.Role_WEREWOLF, pb.Role_SEER, pb.Role_VILLAGER, pb.Role_VILLAGER)
+	s.SetRateLimit(1, 2)
+	signer := identity.NewSigner("secret")
+
+	// panic 转换为 Internal，不影响其他请求
+	_, err := recoveryUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/werewolf.v1.WerewolfService/NightAction"},
+		func(ctx context.Context, req interface{}) (interface{}, error) { panic("boom") })
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(s.ServerOptions(signer)...)
+	pb.RegisterWerewolfServiceServer(srv, s)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(signer.UnaryClientInterceptor()))
+	assert.NoError(t, err)
+	defer conn.Close()
+	client := pb.NewWerewolfServiceClient(conn)
+
+	chat := func(playerID string) error {
+		ctx := identity.NewContext(context.Background(), playerID)
+		_, err := client.SendChatMessage(ctx, &pb.SendChatMessageRequest{
+			RoomId:   room.ID,
+			PlayerId: playerID,
+			Channel:  pb.ChatChannel_CHAT_CHANNEL_WEREWOLF,
+			Content:  "hi",
+		})
+		return err
+	}
+
+	// 突发额度用完后限流，其他玩家不受影响
+	assert.NoError(t, chat("p1"))
+	assert.NoError(t, chat("p1"))
+	err = chat("p1")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, pb.ErrorReason_ERROR_REASON_RATE_LIMITED, statusReason(err))
+	assert.NoError(t, chat("p2"))
+
+	// 查询类请求不限流
+	_, err = client.GetGameState(identity.NewContext(context.Background(), "p1"), &pb.GetGameStateRequest{RoomId: room.ID, PlayerId: "p1"})
+	assert.NoError(t, err)
+}
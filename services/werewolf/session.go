@@ -0,0 +1,303 @@
+package werewolf
+
+import (
+	"context"
+	"io"
+
+	pb "liam/pkg/werewolf/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// gameSession 一条 GameSession 流的状态，只在处理该流的协程中使用
+type gameSession struct {
+	room     *GameRoom
+	playerID string
+	viewerID string // 观战者为 viewerKey，否则与 playerID 相同
+	events   chan *pb.GameEvent
+	backlog  []*pb.GameEvent // 加入时需要补发的历史事件
+}
+
+// GameSession 双向流会话
+// 客户端先发送 join，之后在同一条流上发送行动、投票、聊天和确认，服务端推送事件和每个请求的结果
+func (s *WerewolfServer) GameSession(stream pb.WerewolfService_GameSessionServer) error {
+	ctx := stream.Context()
+
+	requests := make(chan *pb.SessionRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	sess := &gameSession{}
+	defer func() {
+		if sess.room != nil {
+			sess.room.mu.Lock()
+			if sess.events != nil {
+				sess.room.unsubscribe(sess.viewerID, sess.events)
+			}
+			if sess.viewerID != sess.playerID {
+				sess.room.unwatch(sess.playerID)
+			} else {
+				sess.room.disconnect(sess.playerID)
+			}
+			sess.room.mu.Unlock()
+		}
+	}()
+
+	for {
+		select {
+		case req := <-requests:
+			resp := s.handleSessionRequest(ctx, sess, req)
+			if resp != nil {
+				resp.RequestId = req.RequestId
+				if err := stream.Send(resp); err != nil {
+					return err
+				}
+			}
+
+			// 加入成功后先补发历史事件
+			for _, event := range sess.backlog {
+				if err := stream.Send(sessionEvent(event)); err != nil {
+					return err
+				}
+			}
+			sess.backlog = nil
+
+		case event := <-sess.events:
+			if err := stream.Send(sessionEvent(event)); err != nil {
+				return err
+			}
+
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// handleSessionRequest 处理会话请求，行动类请求由会话执行，一元 RPC 也经由同样的会话方法
+// 确认消息没有响应，返回 nil
+func (s *WerewolfServer) handleSessionRequest(ctx context.Context, sess *gameSession, req *pb.SessionRequest) *pb.SessionResponse {
+	if join := req.GetJoin(); join != nil {
+		return s.joinSession(ctx, sess, join)
+	}
+
+	if sess.room == nil {
+		return sessionError(status.Error(codes.FailedPrecondition, "请先加入房间"))
+	}
+
+	// 会话内的请求不经过一元拦截器，在这里与一元请求共用限流
+	if _, ack := req.Request.(*pb.SessionRequest_Ack); !ack && !s.allowRequest(sess.playerID) {
+		return sessionError(errRateLimited)
+	}
+
+	switch r := req.Request.(type) {
+	case *pb.SessionRequest_NightAction:
+		return &pb.SessionResponse{Response: &pb.SessionResponse_NightAction{NightAction: sess.nightAction(r.NightAction)}}
+
+	case *pb.SessionRequest_Vote:
+		return &pb.SessionResponse{Response: &pb.SessionResponse_Vote{Vote: sess.vote(r.Vote)}}
+
+	case *pb.SessionRequest_Chat:
+		return &pb.SessionResponse{Response: &pb.SessionResponse_Chat{Chat: sess.chat(r.Chat)}}
+
+	case *pb.SessionRequest_Ready:
+		return &pb.SessionResponse{Response: &pb.SessionResponse_Ready{Ready: sess.ready(r.Ready.Ready)}}
+
+	case *pb.SessionRequest_EndSpeech:
+		return &pb.SessionResponse{Response: &pb.SessionResponse_EndSpeech{EndSpeech: sess.endSpeech()}}
+
+	case *pb.SessionRequest_Ack:
+		sess.ack(r.Ack.Seq)
+		return nil
+	}
+
+	return sessionError(status.Error(codes.InvalidArgument, "未知的请求类型"))
+}
+
+// unarySession 一元 RPC 的兼容层：校验身份后为单个请求建立不订阅事件的临时会话
+func (s *WerewolfServer) unarySession(ctx context.Context, roomID, playerID string) (*gameSession, error) {
+	if err := checkIdentity(ctx, playerID); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	room, exists := s.rooms[roomID]
+	s.mu.RUnlock()
+
+	if !exists {
+		return nil, errRoomNotFound
+	}
+	return &gameSession{room: room, playerID: playerID, viewerID: playerID}, nil
+}
+
+// nightAction 以会话玩家的身份执行夜晚行动，请求中的 room_id 和 player_id 以会话为准
+func (sess *gameSession) nightAction(req *pb.NightActionRequest) *pb.NightActionResponse {
+	req.RoomId, req.PlayerId = sess.room.ID, sess.playerID
+
+	sess.room.mu.Lock()
+	defer sess.room.mu.Unlock()
+
+	resp := sess.room.nightAction(req)
+	if resp.Success {
+		sess.room.markActive(sess.room.Players[sess.playerID])
+	}
+	return resp
+}
+
+// vote 以会话玩家的身份投票
+func (sess *gameSession) vote(req *pb.VoteRequest) *pb.VoteResponse {
+	req.RoomId, req.VoterId = sess.room.ID, sess.playerID
+
+	sess.room.mu.Lock()
+	defer sess.room.mu.Unlock()
+
+	resp := sess.room.vote(req)
+	if resp.Success {
+		sess.room.markActive(sess.room.Players[sess.playerID])
+	}
+	return resp
+}
+
+// chat 以会话玩家的身份发送聊天消息
+func (sess *gameSession) chat(req *pb.SendChatMessageRequest) *pb.SendChatMessageResponse {
+	req.RoomId, req.PlayerId = sess.room.ID, sess.playerID
+
+	sess.room.mu.Lock()
+	defer sess.room.mu.Unlock()
+
+	return sess.room.sendChat(req)
+}
+
+// ready 切换会话玩家的准备状态
+func (sess *gameSession) ready(ready bool) *pb.SessionActionResult {
+	sess.room.mu.Lock()
+	defer sess.room.mu.Unlock()
+
+	return sess.room.setReady(sess.playerID, ready)
+}
+
+// endSpeech 会话玩家结束发言
+func (sess *gameSession) endSpeech() *pb.SessionActionResult {
+	sess.room.mu.Lock()
+	defer sess.room.mu.Unlock()
+
+	return sess.room.endSpeech(sess.playerID)
+}
+
+// ack 确认会话已收到 seq 及之前的事件
+func (sess *gameSession) ack(seq int64) {
+	sess.room.mu.Lock()
+	defer sess.room.mu.Unlock()
+
+	sess.room.ackEvents(sess.viewerID, seq)
+}
+
+// joinSession 将会话接入房间：已入座的玩家直接接入，要求观战或未入座且不提供名字时作为观战者接入，否则入座
+// skip_events 时不订阅实时事件，只补发历史事件
+func (s *WerewolfServer) joinSession(ctx context.Context, sess *gameSession, join *pb.SessionJoin) *pb.SessionResponse {
+	if sess.room != nil {
+		return sessionError(status.Error(codes.AlreadyExists, "会话已加入房间"))
+	}
+	if join.PlayerId == "" {
+		return sessionError(status.Error(codes.InvalidArgument, "player_id 不能为空"))
+	}
+	if err := checkIdentity(ctx, join.PlayerId); err != nil {
+		return sessionError(err)
+	}
+
+	s.mu.RLock()
+	room, exists := s.rooms[join.RoomId]
+	s.mu.RUnlock()
+
+	if !exists {
+		return sessionError(errRoomNotFound)
+	}
+
+	room.mu.RLock()
+	_, seated := room.Players[join.PlayerId]
+	room.mu.RUnlock()
+
+	spectate := join.Spectate || (!seated && join.PlayerName == "")
+	resp := &pb.JoinRoomResponse{Success: true, Message: "已接入房间"}
+	if spectate || !seated {
+		joined, err := s.JoinRoom(ctx, &pb.JoinRoomRequest{
+			RoomId:     join.RoomId,
+			PlayerId:   join.PlayerId,
+			PlayerName: join.PlayerName,
+			Spectate:   spectate,
+			GodView:    join.GodView,
+		})
+		if err != nil {
+			return sessionError(err)
+		}
+		if !joined.Success {
+			return &pb.SessionResponse{Response: &pb.SessionResponse_Join{Join: joined}}
+		}
+		resp.Message = joined.Message
+	}
+
+	viewerID := viewerKey(join.PlayerId, spectate)
+	room.mu.Lock()
+	defer room.mu.Unlock()
+
+	if player, ok := room.Players[join.PlayerId]; ok {
+		resp.Player = proto.Clone(player).(*pb.Player)
+	}
+
+	// 未指定序号时从上次确认的位置补发
+	since := join.LastSeq
+	if since == 0 {
+		since = room.eventAcks[viewerID]
+	}
+	if since > 0 {
+		sess.backlog = room.eventsSince(viewerID, since)
+	}
+
+	sess.room = room
+	sess.playerID = join.PlayerId
+	sess.viewerID = viewerID
+	if !join.SkipEvents {
+		sess.events = room.subscribe(viewerID)
+	}
+	if spectate {
+		room.watch(join.PlayerId)
+	} else {
+		room.connect(join.PlayerId)
+	}
+	room.flushDelayedEvents(room.State == pb.GameState_FINISHED)
+
+	return &pb.SessionResponse{Response: &pb.SessionResponse_Join{Join: resp}}
+}
+
+func sessionEvent(event *pb.GameEvent) *pb.SessionResponse {
+	return &pb.SessionResponse{Response: &pb.SessionResponse_Event{Event: event}}
+}
+
+func sessionError(err error) *pb.SessionResponse {
+	st := status.Convert(err)
+	return &pb.SessionResponse{Response: &pb.SessionResponse_Error{Error: &pb.SessionError{
+		Code:    st.Code().String(),
+		Message: st.Message(),
+		Reason:  statusReason(err),
+	}}}
+}
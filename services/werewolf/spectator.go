@@ -0,0 +1,204 @@
+package werewolf
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "liam/pkg/werewolf/v1"
+)
+
+// spectatorPrefix 观战者在订阅和可见性判断中使用的 viewer ID 前缀，避免与玩家ID冲突
+const spectatorPrefix = "spectator:"
+
+// defaultSpectatorDelay 上帝视角默认延迟的阶段数
+const defaultSpectatorDelay = 2
+
+// Spectator 观战者，不占座位
+type Spectator struct {
+	ID      string
+	Name    string
+	GodView bool // 延迟上帝视角
+
+	joined       bool  // 通过 JoinRoom 登记，事件流全部关闭后仍保留，直到 StopSpectating
+	streams      int   // 打开的事件流数量
+	deliveredSeq int64 // 已推送的延迟事件序号
+}
+
+// viewerKey 返回订阅和可见性判断使用的 viewer ID
+func viewerKey(id string, spectator bool) string {
+	if spectator {
+		return spectatorPrefix + id
+	}
+	return id
+}
+
+// ListRooms 大厅房间列表，按创建时间排序
+func (s *WerewolfServer) ListRooms(ctx context.Context, req *pb.ListRoomsRequest) (*pb.ListRoomsResponse, error) {
+	s.mu.RLock()
+	rooms := make([]*GameRoom, 0, len(s.rooms))
+	for _, room := range s.rooms {
+		rooms = append(rooms, room)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(rooms, func(i, j int) bool {
+		return rooms[i].CreatedAt.Before(rooms[j].CreatedAt)
+	})
+
+	summaries := make([]*pb.RoomSummary, 0, len(rooms))
+	for _, room := range rooms {
+		room.mu.RLock()
+		if room.State != pb.GameState_FINISHED || req.IncludeFinished {
+			summaries = append(summaries, &pb.RoomSummary{
+				RoomId:         room.ID,
+				RoomName:       room.Name,
+				State:          room.State,
+				PlayerCount:    int32(len(room.Players)),
+				MaxPlayers:     int32(room.MaxPlayers),
+				SpectatorCount: int32(room.spectatorCount()),
+				DayCount:       int32(room.DayCount),
+			})
+		}
+		room.mu.RUnlock()
+	}
+
+	return &pb.ListRoomsResponse{Rooms: summaries}, nil
+}
+
+// joinAsSpectator 以观战者身份加入房间
+// 调用方需持有 room.mu
+func (room *GameRoom) joinAsSpectator(req *pb.JoinRoomRequest) *pb.JoinRoomResponse {
+	if _, seated := room.Players[req.PlayerId]; seated {
+		return &pb.JoinRoomResponse{
+			Success: false,
+			Message: "玩家不能观战自己所在的房间",
+			Reason:  pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED,
+		}
+	}
+
+	if req.GodView && !room.Visibility.GetSpectatorGodView() {
+		return &pb.JoinRoomResponse{
+			Success: false,
+			Message: "房间未开放上帝视角观战",
+			Reason:  pb.ErrorReason_ERROR_REASON_ACTION_NOT_ALLOWED,
+		}
+	}
+
+	room.addSpectator(req.PlayerId, req.PlayerName, req.GodView).joined = true
+
+	message := "已加入观战"
+	if req.GodView {
+		message = fmt.Sprintf("已加入观战，上帝视角延迟 %d 个阶段", room.spectatorDelay())
+	}
+	return &pb.JoinRoomResponse{
+		Success: true,
+		Message: message,
+	}
+}
+
+// addSpectator 登记观战者
+// 调用方需持有 room.mu
+func (room *GameRoom) addSpectator(id, name string, godView bool) *Spectator {
+	if name == "" {
+		name = id
+	}
+	spectator, ok := room.Spectators[id]
+	if !ok {
+		spectator = &Spectator{ID: id}
+		room.Spectators[id] = spectator
+	}
+	spectator.Name = name
+	spectator.GodView = godView
+	return spectator
+}
+
+// watch 观战者打开一条事件流，未通过 JoinRoom 登记时按公开视角临时登记，已登记的不做改动
+// 调用方需持有 room.mu
+func (room *GameRoom) watch(id string) {
+	spectator, ok := room.Spectators[id]
+	if !ok {
+		spectator = room.addSpectator(id, "", false)
+	}
+	spectator.streams++
+}
+
+// unwatch 观战者关闭一条事件流，最后一条关闭时移除临时登记的观战者
+// 调用方需持有 room.mu
+func (room *GameRoom) unwatch(id string) {
+	spectator, ok := room.Spectators[id]
+	if !ok {
+		return
+	}
+	spectator.streams--
+	if spectator.streams <= 0 && !spectator.joined {
+		delete(room.Spectators, id)
+	}
+}
+
+// spectatorFor 返回 viewer 对应的观战者，viewer 不是观战者时返回 nil
+func (room *GameRoom) spectatorFor(viewerID string) *Spectator {
+	if !strings.HasPrefix(viewerID, spectatorPrefix) {
+		return nil
+	}
+	return room.Spectators[strings.TrimPrefix(viewerID, spectatorPrefix)]
+}
+
+// isDelayedViewer 开启上帝视角的观战者不接收实时事件，只接收延迟推送的完整事件
+func (room *GameRoom) isDelayedViewer(viewerID string) bool {
+	spectator := room.spectatorFor(viewerID)
+	return spectator != nil && spectator.GodView
+}
+
+func (room *GameRoom) spectatorDelay() int {
+	if d := room.Visibility.GetSpectatorDelayPhases(); d > 0 {
+		return int(d)
+	}
+	return defaultSpectatorDelay
+}
+
+// spectatorCount 当前在线的观战者数量，只统计有事件流打开的观战者
+func (room *GameRoom) spectatorCount() int {
+	count := 0
+	for _, spectator := range room.Spectators {
+		if spectator.streams > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// flushDelayedEvents 向上帝视角观战者推送已超过延迟阶段数的完整事件，包括私密事件
+// all 为 true 时推送全部事件，用于游戏结束后
+// 调用方需持有 room.mu
+func (room *GameRoom) flushDelayedEvents(all bool) {
+	visibleBefore := room.phaseSeq - room.spectatorDelay()
+
+	for viewerID, ch := range room.Subscribers {
+		spectator := room.spectatorFor(viewerID)
+		if spectator == nil || !spectator.GodView {
+			continue
+		}
+
+	deliver:
+		for _, logged := range room.eventLog {
+			if logged.event.Seq <= spectator.deliveredSeq {
+				continue
+			}
+			if !all && logged.phaseSeq > visibleBefore {
+				break
+			}
+			// 行动提示对观战者没有意义
+			if logged.event.EventType != pb.GameEvent_EVENT_YOUR_TURN {
+				select {
+				case ch <- logged.event:
+				default:
+					// 通道满了，下次再推送
+					break deliver
+				}
+			}
+			spectator.deliveredSeq = logged.event.Seq
+		}
+	}
+}
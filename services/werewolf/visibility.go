@@ -0,0 +1,76 @@
+package werewolf
+
+import (
+	pb "liam/pkg/werewolf/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// canSeeRole 判断 viewer 能否看到 target 的身份
+// 规则：自己始终可见；狼人互相可见；情侣互相可见；
+// 按房间配置，死亡玩家可获得上帝视角；观战者（未入座的订阅者）实时只能看到公开信息，
+// 上帝视角通过延迟推送获得，见 flushDelayedEvents
+// 调用方需持有 room.mu
+func (room *GameRoom) canSeeRole(viewerID string, target *pb.Player) bool {
+	if viewerID == target.PlayerId {
+		return true
+	}
+
+	viewer, seated := room.Players[viewerID]
+	if !seated {
+		return false
+	}
+
+	if !viewer.IsAlive && room.Visibility.GetDeadGodView() {
+		return true
+	}
+
+	if viewer.Role == pb.Role_WEREWOLF && target.Role == pb.Role_WEREWOLF {
+		return true
+	}
+
+	if lover, ok := room.Lovers[viewerID]; ok && lover == target.PlayerId {
+		return true
+	}
+
+	return false
+}
+
+// visiblePlayer 返回 viewer 视角下的玩家信息，不可见时隐藏身份和行动状态
+func (room *GameRoom) visiblePlayer(viewerID string, player *pb.Player) *pb.Player {
+	visible := &pb.Player{
+		PlayerId: player.PlayerId,
+		Name:     player.Name,
+		IsAlive:  player.IsAlive,
+		Position: player.Position,
+		Role:     pb.Role_UNKNOWN,
+		Camp:     pb.Camp_CAMP_UNKNOWN,
+		// 挂机、托管、准备和连接状态公开
+		IsAfk:         player.IsAfk,
+		BotControlled: player.BotControlled,
+		Ready:         player.Ready,
+		Presence:      player.Presence,
+		LastSeen:      player.LastSeen,
+	}
+
+	if room.canSeeRole(viewerID, player) {
+		visible.Role = player.Role
+		visible.Camp = player.Camp
+		visible.CanAct = player.CanAct
+	}
+
+	return visible
+}
+
+// projectEvent 按 viewer 的可见性过滤事件中的玩家身份
+func (room *GameRoom) projectEvent(viewerID string, event *pb.GameEvent) *pb.GameEvent {
+	if len(event.AffectedPlayers) == 0 {
+		return event
+	}
+
+	projected := proto.Clone(event).(*pb.GameEvent)
+	for i, player := range event.AffectedPlayers {
+		projected.AffectedPlayers[i] = room.visiblePlayer(viewerID, player)
+	}
+	return projected
+}
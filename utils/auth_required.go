@@ -30,13 +30,7 @@ func AuthRequired() gin.HandlerFunc {
 			})
 			return
 		}
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwt.ErrSignatureInvalid
-			}
-			return jwtSecret, nil
-		})
-
+		claims, err := ParseToken(tokenString)
 		if err != nil {
 			log.Printf("JWT parsing error: %v", err)
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
@@ -45,33 +39,44 @@ func AuthRequired() gin.HandlerFunc {
 			return
 		}
 
-		if !token.Valid {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "Invalid token",
-			})
-			return
-		}
-
-		if claims, ok := token.Claims.(jwt.MapClaims); ok {
+		log.Printf("JWT Claims: %+v", claims) // 打印所有解析出的 claims
 
-			log.Printf("JWT Claims: %+v", claims) // 打印所有解析出的 claims
-
-			// 检查 user_id 是否存在并且非空
-			if _, exists := claims["user_id"]; !exists {
-				log.Println("Warning: 'user_id' claim not found in JWT token.")
-			} else {
-				log.Printf("Setting user_id in context: %v", claims["user_id"])
-			}
-			c.Set("user_id", claims["user_id"])
-			c.Set("user_name", claims["user_name"])
+		// 检查 user_id 是否存在并且非空
+		if _, exists := claims["user_id"]; !exists {
+			log.Println("Warning: 'user_id' claim not found in JWT token.")
 		} else {
-			log.Println("Error: Token claims could not be cast to jwt.MapClaims.")
+			log.Printf("Setting user_id in context: %v", claims["user_id"])
 		}
+		c.Set("user_id", claims["user_id"])
+		c.Set("user_name", claims["user_name"])
 		// 令牌有效，继续处理请求
 		c.Next()
 	}
 }
 
+// ParseToken 校验令牌签名和有效期，返回其中的 claims
+// 用于无法携带 Authorization 头的场景，例如浏览器发起的 WebSocket 连接
+func ParseToken(tokenString string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return jwtSecret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return claims, nil
+}
+
 func GenerateToken(userID uint, username string) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"user_id":   userID,